@@ -2,14 +2,17 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,6 +23,12 @@ import (
 
 const (
 	SweepThrottlingRetryTimeout = 10 * time.Minute
+
+	// Default maximum number of resources a sweeper deletes in parallel
+	SweepDefaultConcurrency = 10
+
+	// Default maximum number of times a throttled resource deletion is retried
+	SweepDefaultMaxRetries = 25
 )
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
@@ -90,16 +99,228 @@ func sharedClientForRegion(region string) (interface{}, error) {
 }
 
 type testSweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	d            *schema.ResourceData
+	dependencies []*testSweepResource
+	meta         interface{}
+	resource     *schema.Resource
 }
 
-func NewTestSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *testSweepResource {
+// NewTestSweepResource returns a resource to be swept.
+// Any dependencies, e.g. child resources, are swept before the resource itself.
+func NewTestSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, dependencies ...*testSweepResource) *testSweepResource {
 	return &testSweepResource{
-		d:        d,
-		meta:     meta,
-		resource: resource,
+		d:            d,
+		dependencies: dependencies,
+		meta:         meta,
+		resource:     resource,
+	}
+}
+
+// testSweepResourceWaves orders the specified resources into waves that can each be swept in parallel.
+// A resource is placed in a later wave than all of its dependencies.
+// Dependencies that are not in the specified resources are ignored.
+func testSweepResourceWaves(sweepResources []*testSweepResource) ([][]*testSweepResource, error) {
+	remaining := make(map[*testSweepResource]bool, len(sweepResources))
+
+	for _, sweepResource := range sweepResources {
+		remaining[sweepResource] = true
+	}
+
+	var waves [][]*testSweepResource
+
+	for len(remaining) > 0 {
+		var wave []*testSweepResource
+
+		for _, sweepResource := range sweepResources {
+			if !remaining[sweepResource] {
+				continue
+			}
+
+			ready := true
+
+			for _, dependency := range sweepResource.dependencies {
+				if remaining[dependency] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				wave = append(wave, sweepResource)
+			}
+		}
+
+		if len(wave) == 0 {
+			return nil, fmt.Errorf("dependency cycle between %d sweep resources", len(remaining))
+		}
+
+		for _, sweepResource := range wave {
+			delete(remaining, sweepResource)
+		}
+
+		waves = append(waves, wave)
+	}
+
+	return waves, nil
+}
+
+func TestSweepResourceWaves(t *testing.T) {
+	identityProviderConfig1 := NewTestSweepResource(nil, nil, nil)
+	identityProviderConfig2 := NewTestSweepResource(nil, nil, nil)
+	notSwept := NewTestSweepResource(nil, nil, nil)
+	cluster := NewTestSweepResource(nil, nil, nil, identityProviderConfig1, identityProviderConfig2, notSwept)
+	other := NewTestSweepResource(nil, nil, nil)
+
+	waves, err := testSweepResourceWaves([]*testSweepResource{cluster, identityProviderConfig1, other, identityProviderConfig2})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := [][]*testSweepResource{
+		{identityProviderConfig1, other, identityProviderConfig2},
+		{cluster},
+	}
+
+	if len(waves) != len(expected) {
+		t.Fatalf("expected %d waves, got %d", len(expected), len(waves))
+	}
+
+	for i := range expected {
+		if len(waves[i]) != len(expected[i]) {
+			t.Fatalf("wave %d: expected %d resources, got %d", i, len(expected[i]), len(waves[i]))
+		}
+
+		for j := range expected[i] {
+			if waves[i][j] != expected[i][j] {
+				t.Errorf("wave %d: unexpected resource at position %d", i, j)
+			}
+		}
+	}
+
+	a := NewTestSweepResource(nil, nil, nil)
+	b := NewTestSweepResource(nil, nil, nil, a)
+	a.dependencies = append(a.dependencies, b)
+
+	if _, err := testSweepResourceWaves([]*testSweepResource{a, b}); err == nil {
+		t.Error("expected dependency cycle error")
+	}
+}
+
+func TestSweepResourceWaveConcurrency(t *testing.T) {
+	const concurrency = 3
+	var active, maxActive int32
+
+	r := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			n := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+
+			for {
+				max := atomic.LoadInt32(&maxActive)
+				if n <= max || atomic.CompareAndSwapInt32(&maxActive, max, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			if id := d.Id(); strings.HasPrefix(id, "fail") {
+				return fmt.Errorf("error deleting %s", id)
+			}
+
+			return nil
+		},
+	}
+
+	var sweepResources []*testSweepResource
+
+	for i := 0; i < 20; i++ {
+		d := r.Data(nil)
+
+		if i%4 == 0 {
+			d.SetId(fmt.Sprintf("fail-%d", i))
+		} else {
+			d.SetId(fmt.Sprintf("ok-%d", i))
+		}
+
+		sweepResources = append(sweepResources, NewTestSweepResource(r, d, nil))
+	}
+
+	err := testSweepResourceWaveContext(context.Background(), sweepResources, concurrency, 0, 0, 0, 0, 0, 1*time.Minute)
+
+	if got := atomic.LoadInt32(&maxActive); got > concurrency {
+		t.Errorf("expected at most %d concurrent deletions, got %d", concurrency, got)
+	}
+
+	var merr *multierror.Error
+
+	if !errors.As(err, &merr) {
+		t.Fatalf("expected multierror, got: %v", err)
+	}
+
+	if got, want := len(merr.Errors), 5; got != want {
+		t.Errorf("expected %d errors, got %d: %s", want, got, err)
+	}
+}
+
+func TestSweepResourceDeleteThrottling(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Errors        []error
+		MaxRetries    int
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "throttling then success",
+			Errors:        []error{awserr.New("ThrottlingException", "Rate exceeded", nil), fmt.Errorf("error deleting: %w", awserr.New("RequestLimitExceeded", "Request limit exceeded", nil)), nil},
+			MaxRetries:    5,
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "permanent error",
+			Errors:        []error{awserr.New("InvalidParameterValue", "Invalid", nil)},
+			MaxRetries:    5,
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "max retries exceeded",
+			Errors:        []error{awserr.New("Throttling", "Rate exceeded", nil), awserr.New("Throttling", "Rate exceeded", nil), awserr.New("Throttling", "Rate exceeded", nil)},
+			MaxRetries:    2,
+			ExpectedCalls: 3,
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var calls int
+
+			r := &schema.Resource{
+				Delete: func(d *schema.ResourceData, meta interface{}) error {
+					err := testCase.Errors[calls]
+					calls++
+
+					return err
+				},
+			}
+			d := r.Data(nil)
+			d.SetId("test")
+
+			err := testSweepResourceDeleteContext(context.Background(), NewTestSweepResource(r, d, nil), testCase.MaxRetries, 0, 0, 10*time.Millisecond, 10*time.Millisecond, 1*time.Minute)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.ExpectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.ExpectedCalls, calls)
+			}
+		})
 	}
 }
 
@@ -108,36 +329,102 @@ func testSweepResourceOrchestrator(sweepResources []*testSweepResource) error {
 }
 
 func testSweepResourceOrchestratorContext(ctx context.Context, sweepResources []*testSweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	waves, err := testSweepResourceWaves(sweepResources)
+
+	if err != nil {
+		return err
+	}
+
+	concurrency := testSweepConcurrency()
+	maxRetries := testSweepMaxRetries()
+	var errs *multierror.Error
+
+	for _, wave := range waves {
+		errs = multierror.Append(errs, testSweepResourceWaveContext(ctx, wave, concurrency, maxRetries, delay, delayRand, minTimeout, pollInterval, timeout))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// testSweepConcurrency returns the maximum number of resources a sweeper deletes in parallel.
+func testSweepConcurrency() int {
+	if v := os.Getenv(envvar.TfAwsSweepConcurrency); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+
+		log.Printf("[WARN] Invalid %s value (%s), using default (%d)", envvar.TfAwsSweepConcurrency, v, SweepDefaultConcurrency)
+	}
+
+	return SweepDefaultConcurrency
+}
+
+// testSweepMaxRetries returns the maximum number of times a throttled resource deletion is retried.
+func testSweepMaxRetries() int {
+	if v := os.Getenv(envvar.TfAwsSweepMaxRetries); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			return n
+		}
+
+		log.Printf("[WARN] Invalid %s value (%s), using default (%d)", envvar.TfAwsSweepMaxRetries, v, SweepDefaultMaxRetries)
+	}
+
+	return SweepDefaultMaxRetries
+}
+
+func testSweepResourceWaveContext(ctx context.Context, sweepResources []*testSweepResource, concurrency int, maxRetries int, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
+	sem := make(chan struct{}, concurrency)
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
 		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := testAccDeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			return testSweepResourceDeleteContext(ctx, sweepResource, maxRetries, delay, delayRand, minTimeout, pollInterval, timeout)
+		})
+	}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+	return g.Wait().ErrorOrNil()
+}
 
-					return resource.NonRetryableError(err)
-				}
+// testSweepResourceDeleteContext deletes the specified resource, retrying throttled deletions at most maxRetries times.
+func testSweepResourceDeleteContext(ctx context.Context, sweepResource *testSweepResource, maxRetries int, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	var retries int
 
-				return nil
-			})
+	err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+		err := testAccDeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
-			if tfresource.TimedOut(err) {
-				err = testAccDeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		if err != nil {
+			if testSweepThrottlingError(err) && retries < maxRetries {
+				retries++
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying (%d/%d)...", sweepResource.d.Id(), err, retries, maxRetries)
+				return resource.RetryableError(err)
 			}
 
-			return err
-		})
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = testAccDeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 	}
 
-	return g.Wait().ErrorOrNil()
+	return err
+}
+
+// Check sweeper API call error for throttling or request limits
+// Resource deletions failing with these errors are retried
+func testSweepThrottlingError(err error) bool {
+	if tfawserr.ErrCodeEquals(err, "Throttling", "ThrottlingException", "RequestLimitExceeded", "TooManyRequestsException", "RequestThrottled", "RequestThrottledException") {
+		return true
+	}
+	// Not all resources wrap the underlying AWS error
+	return strings.Contains(err.Error(), "Throttling")
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -215,6 +215,8 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	SagemakerDefaultExecutionRoleArn string
+
 	terraformVersion string
 }
 
@@ -365,6 +367,7 @@ type AWSClient struct {
 	s3controlconn                       *s3control.S3Control
 	s3outpostsconn                      *s3outposts.S3Outposts
 	sagemakerconn                       *sagemaker.SageMaker
+	SagemakerDefaultExecutionRoleArn    string
 	scconn                              *servicecatalog.ServiceCatalog
 	schemasconn                         *schemas.Schemas
 	sdconn                              *servicediscovery.ServiceDiscovery
@@ -616,6 +619,7 @@ func (c *Config) Client() (interface{}, error) {
 		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
 		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
 		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
+		SagemakerDefaultExecutionRoleArn:    c.SagemakerDefaultExecutionRoleArn,
 		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
 		schemasconn:                         schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["schemas"])})),
 		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
@@ -66,6 +66,11 @@ func dataSourceAwsAppmeshVirtualService() *schema.Resource {
 										Computed: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"virtual_node_arn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
 												"virtual_node_name": {
 													Type:     schema.TypeString,
 													Computed: true,
@@ -79,6 +84,11 @@ func dataSourceAwsAppmeshVirtualService() *schema.Resource {
 										Computed: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"virtual_router_arn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
 												"virtual_router_name": {
 													Type:     schema.TypeString,
 													Computed: true,
@@ -93,7 +103,7 @@ func dataSourceAwsAppmeshVirtualService() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags": tagsSchemaComputed(),
 		},
 	}
 }
@@ -128,7 +138,8 @@ func dataSourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interfa
 	d.Set("last_updated_date", resp.VirtualService.Metadata.LastUpdatedAt.Format(time.RFC3339))
 	d.Set("resource_owner", resp.VirtualService.Metadata.ResourceOwner)
 
-	err = d.Set("spec", flattenAppmeshVirtualServiceSpec(resp.VirtualService.Spec))
+	meshARN := appmeshMeshARN(meta, aws.StringValue(resp.VirtualService.Metadata.MeshOwner), aws.StringValue(resp.VirtualService.MeshName))
+	err = d.Set("spec", flattenAppmeshVirtualServiceSpec(resp.VirtualService.Spec, meshARN))
 	if err != nil {
 		return fmt.Errorf("error setting spec: %s", err)
 	}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+func dataSourceAwsDbProxyEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDbProxyEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_proxy_endpoint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_proxy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDbProxyEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	name := d.Get("db_proxy_endpoint_name").(string)
+	dbProxyEndpoint, err := finder.DBProxyEndpointByName(conn, d.Get("db_proxy_name").(string), name)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(dbProxyEndpoint.DBProxyEndpointArn))
+	d.Set("arn", dbProxyEndpoint.DBProxyEndpointArn)
+	d.Set("db_proxy_name", dbProxyEndpoint.DBProxyName)
+	d.Set("endpoint", dbProxyEndpoint.Endpoint)
+	d.Set("is_default", dbProxyEndpoint.IsDefault)
+	d.Set("status", dbProxyEndpoint.Status)
+	d.Set("target_role", dbProxyEndpoint.TargetRole)
+	d.Set("vpc_id", dbProxyEndpoint.VpcId)
+	d.Set("vpc_security_group_ids", aws.StringValueSlice(dbProxyEndpoint.VpcSecurityGroupIds))
+	d.Set("vpc_subnet_ids", aws.StringValueSlice(dbProxyEndpoint.VpcSubnetIds))
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDBProxyEndpointDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_db_proxy_endpoint.test"
+	resourceName := "aws_db_proxy_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccDBProxyEndpointPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, rds.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyEndpointDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_endpoint_name", resourceName, "db_proxy_endpoint_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_name", resourceName, "db_proxy_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint", resourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "is_default", resourceName, "is_default"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", rds.DBProxyEndpointStatusAvailable),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_role", resourceName, "target_role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_security_group_ids", resourceName, "vpc_security_group_ids"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_subnet_ids", resourceName, "vpc_subnet_ids"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyEndpointDataSource_default(t *testing.T) {
+	dataSourceName := "data.aws_db_proxy_endpoint.test"
+	proxyResourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccDBProxyEndpointPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, rds.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyEndpointDataSourceConfigDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "db_proxy_endpoint_name", "default"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_name", proxyResourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint", proxyResourceName, "endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDBProxyEndpointDataSourceConfig(rName string) string {
+	return testAccAWSDBProxyEndpointConfig(rName) + `
+data "aws_db_proxy_endpoint" "test" {
+  db_proxy_endpoint_name = aws_db_proxy_endpoint.test.db_proxy_endpoint_name
+}
+`
+}
+
+func testAccAWSDBProxyEndpointDataSourceConfigDefault(rName string) string {
+	return testAccAWSDBProxyEndpointConfigBase(rName) + `
+data "aws_db_proxy_endpoint" "test" {
+  db_proxy_endpoint_name = "default"
+  db_proxy_name          = aws_db_proxy.test.name
+}
+`
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+)
+
+func dataSourceAwsEksAddonVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsEksAddonVersionRead,
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubernetes_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEksAddonVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).eksconn
+
+	addonName := d.Get("addon_name").(string)
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+
+	versions, err := finder.AddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, addonName, kubernetesVersion)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Add-On (%s) versions for Kubernetes version (%s): %w", addonName, kubernetesVersion, err))
+	}
+
+	defaultVersion, mostRecentVersion := eksAddonDefaultAndMostRecentVersions(versions, kubernetesVersion)
+
+	version := defaultVersion
+
+	if d.Get("most_recent").(bool) || version == "" {
+		version = mostRecentVersion
+	}
+
+	d.SetId(addonName)
+	d.Set("addon_name", addonName)
+	d.Set("default_version", defaultVersion)
+	d.Set("kubernetes_version", kubernetesVersion)
+	d.Set("version", version)
+
+	return nil
+}
+
+// eksAddonDefaultAndMostRecentVersions returns the default add-on version for the specified
+// Kubernetes version and the most recent add-on version.
+func eksAddonDefaultAndMostRecentVersions(versions []*eks.AddonVersionInfo, kubernetesVersion string) (string, string) {
+	var defaultVersion, mostRecentVersion string
+	var mostRecent *gversion.Version
+
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+
+		addonVersion := aws.StringValue(v.AddonVersion)
+
+		for _, compatibility := range v.Compatibilities {
+			if compatibility == nil {
+				continue
+			}
+
+			if aws.StringValue(compatibility.ClusterVersion) == kubernetesVersion && aws.BoolValue(compatibility.DefaultVersion) {
+				defaultVersion = addonVersion
+			}
+		}
+
+		parsed, err := gversion.NewVersion(addonVersion)
+
+		if err != nil {
+			// Versions that cannot be parsed are only used when none can be,
+			// relying on the API returning the most recent version first.
+			if mostRecent == nil && mostRecentVersion == "" {
+				mostRecentVersion = addonVersion
+			}
+
+			continue
+		}
+
+		if mostRecent == nil || parsed.GreaterThan(mostRecent) {
+			mostRecent = parsed
+			mostRecentVersion = addonVersion
+		}
+	}
+
+	return defaultVersion, mostRecentVersion
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEksAddonDefaultAndMostRecentVersions(t *testing.T) {
+	addonVersion := func(version string, compatibilities ...*eks.Compatibility) *eks.AddonVersionInfo {
+		return &eks.AddonVersionInfo{
+			AddonVersion:    aws.String(version),
+			Compatibilities: compatibilities,
+		}
+	}
+	compatibility := func(clusterVersion string, defaultVersion bool) *eks.Compatibility {
+		return &eks.Compatibility{
+			ClusterVersion: aws.String(clusterVersion),
+			DefaultVersion: aws.Bool(defaultVersion),
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		Versions           []*eks.AddonVersionInfo
+		ExpectedDefault    string
+		ExpectedMostRecent string
+	}{
+		{
+			Name: "newer than default",
+			Versions: []*eks.AddonVersionInfo{
+				addonVersion("v1.8.0-eksbuild.1", compatibility("1.21", false)),
+				addonVersion("v1.10.1-eksbuild.1", compatibility("1.21", false)),
+				addonVersion("v1.9.0-eksbuild.1", compatibility("1.21", true), compatibility("1.20", false)),
+				nil,
+			},
+			ExpectedDefault:    "v1.9.0-eksbuild.1",
+			ExpectedMostRecent: "v1.10.1-eksbuild.1",
+		},
+		{
+			Name: "default for another Kubernetes version",
+			Versions: []*eks.AddonVersionInfo{
+				addonVersion("v1.9.0-eksbuild.1", compatibility("1.20", true), compatibility("1.21", false)),
+				addonVersion("v1.8.0-eksbuild.1", compatibility("1.21", true)),
+			},
+			ExpectedDefault:    "v1.8.0-eksbuild.1",
+			ExpectedMostRecent: "v1.9.0-eksbuild.1",
+		},
+		{
+			Name: "unparsable versions",
+			Versions: []*eks.AddonVersionInfo{
+				addonVersion("latest", compatibility("1.21", true)),
+				addonVersion("previous", compatibility("1.21", false)),
+			},
+			ExpectedDefault:    "latest",
+			ExpectedMostRecent: "latest",
+		},
+		{
+			Name: "no default",
+			Versions: []*eks.AddonVersionInfo{
+				addonVersion("v1.8.0-eksbuild.1"),
+			},
+			ExpectedMostRecent: "v1.8.0-eksbuild.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			defaultVersion, mostRecentVersion := eksAddonDefaultAndMostRecentVersions(testCase.Versions, "1.21")
+
+			if defaultVersion != testCase.ExpectedDefault {
+				t.Errorf("expected default version %q, got %q", testCase.ExpectedDefault, defaultVersion)
+			}
+
+			if mostRecentVersion != testCase.ExpectedMostRecent {
+				t.Errorf("expected most recent version %q, got %q", testCase.ExpectedMostRecent, mostRecentVersion)
+			}
+		})
+	}
+}
+
+func TestAccAWSEksAddonVersionDataSource_basic(t *testing.T) {
+	addonName := "vpc-cni"
+	kubernetesVersion := "1.21"
+	defaultDataSourceName := "data.aws_eks_addon_version.default"
+	mostRecentDataSourceName := "data.aws_eks_addon_version.most_recent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWSEks(t); testAccPreCheckAWSEksAddon(t) },
+		ErrorCheck:        testAccErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonVersionDataSourceConfig_Basic(addonName, kubernetesVersion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(defaultDataSourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(defaultDataSourceName, "version", defaultDataSourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(mostRecentDataSourceName, "default_version", defaultDataSourceName, "default_version"),
+					testAccCheckAWSEksAddonVersionNotOlder(mostRecentDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAWSEksAddonVersionNotOlder verifies that the selected version is the default version or newer.
+func testAccCheckAWSEksAddonVersionNotOlder(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		version, err := gversion.NewVersion(rs.Primary.Attributes["version"])
+
+		if err != nil {
+			return err
+		}
+
+		defaultVersion, err := gversion.NewVersion(rs.Primary.Attributes["default_version"])
+
+		if err != nil {
+			return err
+		}
+
+		if version.LessThan(defaultVersion) {
+			return fmt.Errorf("most recent version (%s) is older than default version (%s)", version, defaultVersion)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEksAddonVersionDataSourceConfig_Basic(addonName, kubernetesVersion string) string {
+	return fmt.Sprintf(`
+data "aws_eks_addon_version" "default" {
+  addon_name         = %[1]q
+  kubernetes_version = %[2]q
+}
+
+data "aws_eks_addon_version" "most_recent" {
+  addon_name         = %[1]q
+  kubernetes_version = %[2]q
+  most_recent        = true
+}
+`, addonName, kubernetesVersion)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tftransfer "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/transfer"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/transfer/finder"
+)
+
+func dataSourceAwsTransferUser() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"home_directory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"home_directory_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"home_directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTransferServerID,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTransferUserName,
+			},
+		},
+
+		Read: dataSourceAwsTransferUserRead,
+	}
+}
+
+func dataSourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).transferconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+	id := tftransfer.UserCreateResourceID(serverID, userName)
+
+	user, err := finder.UserByServerIDAndUserName(conn, serverID, userName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Transfer User (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("arn", user.Arn)
+	d.Set("home_directory", user.HomeDirectory)
+	if err := d.Set("home_directory_mappings", flattenAwsTransferHomeDirectoryMappings(user.HomeDirectoryMappings)); err != nil {
+		return fmt.Errorf("error setting home_directory_mappings: %w", err)
+	}
+	d.Set("home_directory_type", user.HomeDirectoryType)
+	d.Set("policy", user.Policy)
+	d.Set("role", user.Role)
+
+	if err := d.Set("tags", keyvaluetags.TransferKeyValueTags(user.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsTransferUser_basic(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_transfer_user.test"
+	datasourceName := "data.aws_transfer_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck: testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsTransferUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "home_directory", resourceName, "home_directory"),
+					resource.TestCheckResourceAttrPair(datasourceName, "home_directory_mappings.#", resourceName, "home_directory_mappings.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "home_directory_mappings.0.entry", resourceName, "home_directory_mappings.0.entry"),
+					resource.TestCheckResourceAttrPair(datasourceName, "home_directory_mappings.0.target", resourceName, "home_directory_mappings.0.target"),
+					resource.TestCheckResourceAttrPair(datasourceName, "home_directory_type", resourceName, "home_directory_type"),
+					resource.TestCheckResourceAttrPair(datasourceName, "policy", resourceName, "policy"),
+					resource.TestCheckResourceAttrPair(datasourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsTransferUserConfig_basic(rName string) string {
+	return composeConfig(testAccAWSTransferUserConfig_base, fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-test-transfer-user-iam-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_transfer_user" "test" {
+  home_directory_type = "LOGICAL"
+  role                = aws_iam_role.test.arn
+  server_id           = aws_transfer_server.test.id
+  user_name           = "tftestuser"
+
+  home_directory_mappings {
+    entry  = "/your-personal-report.pdf"
+    target = "/bucket3/customized-reports/tftestuser.pdf"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_transfer_user" "test" {
+  server_id = aws_transfer_user.test.server_id
+  user_name = aws_transfer_user.test.user_name
+}
+`, rName))
+}
@@ -64,3 +64,14 @@ const (
 	// A session name for the assumed role
 	TfAwsAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
+
+// Custom environment variables used for tuning resource sweepers
+const (
+	// The maximum number of resources a sweeper deletes in parallel.
+	// Defaults to 10.
+	TfAwsSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// The maximum number of times a sweeper retries a throttled resource deletion.
+	// Defaults to 25.
+	TfAwsSweepMaxRetries = "TF_AWS_SWEEP_MAX_RETRIES"
+)
@@ -51,3 +51,37 @@ func VirtualGateway(conn *appmesh.AppMesh, meshName, virtualGatewayName, meshOwn
 
 	return output.VirtualGateway, nil
 }
+
+// VirtualGatewayNamesByMeshName returns the names of the virtual gateways in the specified mesh with optional mesh owner.
+func VirtualGatewayNamesByMeshName(conn *appmesh.AppMesh, meshName, meshOwner string) ([]string, error) {
+	input := &appmesh.ListVirtualGatewaysInput{
+		MeshName: aws.String(meshName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	var names []string
+
+	err := conn.ListVirtualGatewaysPages(input, func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, virtualGateway := range page.VirtualGateways {
+			if virtualGateway == nil {
+				continue
+			}
+
+			names = append(names, aws.StringValue(virtualGateway.VirtualGatewayName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 )
 
@@ -87,3 +88,14 @@ func IssuesError(apiObjects []*eks.Issue) error {
 
 	return errors.ErrorOrNil()
 }
+
+// IdentityProviderConfigInUseError wraps the ResourceInUseException returned when associating
+// an identity provider config with a cluster that already has one associated.
+// EKS only supports a single identity provider config per cluster.
+func IdentityProviderConfigInUseError(err error, clusterName string, existingConfigNames []string) error {
+	if !tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceInUseException) || len(existingConfigNames) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("EKS Cluster (%s) already has Identity Provider Config (%s) associated and only one is supported per cluster, remove it before associating another: %w", clusterName, strings.Join(existingConfigNames, ", "), err)
+}
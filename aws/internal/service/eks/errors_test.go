@@ -0,0 +1,66 @@
+package eks_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
+)
+
+func TestIdentityProviderConfigInUseError(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Err                 error
+		ExistingConfigNames []string
+		ExpectedWrapped     bool
+	}{
+		{
+			Name: "no error",
+		},
+		{
+			Name:                "other error",
+			Err:                 errors.New("test error"),
+			ExistingConfigNames: []string{"existing"},
+		},
+		{
+			Name: "in use no existing configs",
+			Err:  awserr.New(eks.ErrCodeResourceInUseException, "Identity provider config already exists", nil),
+		},
+		{
+			Name:                "in use existing config",
+			Err:                 awserr.New(eks.ErrCodeResourceInUseException, "Identity provider config already exists", nil),
+			ExistingConfigNames: []string{"existing"},
+			ExpectedWrapped:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := tfeks.IdentityProviderConfigInUseError(testCase.Err, "test-cluster", testCase.ExistingConfigNames)
+
+			if testCase.Err == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, testCase.Err) {
+				t.Errorf("expected wrapped error %q, got %q", testCase.Err, err)
+			}
+
+			if got := strings.Contains(err.Error(), "Identity Provider Config (existing)"); got != testCase.ExpectedWrapped {
+				t.Errorf("expected existing config name in error (%t), got %q", testCase.ExpectedWrapped, err)
+			}
+
+			if testCase.ExpectedWrapped && !tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceInUseException) {
+				t.Errorf("tfawserr.ErrCodeEquals failed: %s", err)
+			}
+		})
+	}
+}
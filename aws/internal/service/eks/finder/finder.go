@@ -39,6 +39,49 @@ func AddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clusterN
 	return output.Addon, nil
 }
 
+// AddonVersionsByAddonNameAndKubernetesVersion returns the versions of the specified add-on
+// available for the specified Kubernetes version.
+func AddonVersionsByAddonNameAndKubernetesVersion(ctx context.Context, conn *eks.EKS, addonName, kubernetesVersion string) ([]*eks.AddonVersionInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+	var output []*eks.AddonVersionInfo
+
+	err := conn.DescribeAddonVersionsPagesWithContext(ctx, input, func(page *eks.DescribeAddonVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, addon := range page.Addons {
+			if addon == nil || aws.StringValue(addon.AddonName) != addonName {
+				continue
+			}
+
+			for _, v := range addon.AddonVersions {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func AddonUpdateByClusterNameAddonNameAndID(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -247,3 +290,37 @@ func OidcIdentityProviderConfigByClusterNameAndConfigName(ctx context.Context, c
 
 	return output.IdentityProviderConfig.Oidc, nil
 }
+
+func IdentityProviderConfigsByClusterName(ctx context.Context, conn *eks.EKS, clusterName string) ([]*eks.IdentityProviderConfig, error) {
+	input := &eks.ListIdentityProviderConfigsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var output []*eks.IdentityProviderConfig
+
+	err := conn.ListIdentityProviderConfigsPagesWithContext(ctx, input, func(page *eks.ListIdentityProviderConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IdentityProviderConfigs {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
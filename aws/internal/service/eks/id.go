@@ -3,6 +3,9 @@ package eks
 import (
 	"fmt"
 	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/eks"
 )
 
 const addonResourceIDSeparator = ":"
@@ -52,7 +55,16 @@ func IdentityProviderConfigCreateResourceID(clusterName, configName string) stri
 	return id
 }
 
+// IdentityProviderConfigParseResourceID parses either a resource ID of the form
+// cluster-name:config-name or an identity provider config ARN of the form
+// arn:PARTITION:eks:REGION:ACCOUNT:identityproviderconfig/CLUSTER/TYPE/NAME/UUID.
 func IdentityProviderConfigParseResourceID(id string) (string, string, error) {
+	if arn.IsARN(id) {
+		clusterName, configName, _, err := identityProviderConfigParseARN(id)
+
+		return clusterName, configName, err
+	}
+
 	parts := strings.Split(id, identityProviderConfigResourceIDSeparator)
 
 	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
@@ -62,6 +74,35 @@ func IdentityProviderConfigParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sconfig-name", id, identityProviderConfigResourceIDSeparator)
 }
 
+const identityProviderConfigARNResourcePrefix = "identityproviderconfig"
+
+// IdentityProviderConfigIDFromARN returns the unique identifier (UUID) of an identity provider config from its ARN.
+func IdentityProviderConfigIDFromARN(inputARN string) (string, error) {
+	_, _, id, err := identityProviderConfigParseARN(inputARN)
+
+	return id, err
+}
+
+func identityProviderConfigParseARN(inputARN string) (string, string, string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", "", "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, eks.EndpointsID; actual != expected {
+		return "", "", "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 5 || parts[0] != identityProviderConfigARNResourcePrefix || parts[1] == "" || parts[3] == "" || parts[4] == "" {
+		return "", "", "", fmt.Errorf("unexpected format for ARN (%s), expected %s/cluster-name/type/config-name/uuid resource", inputARN, identityProviderConfigARNResourcePrefix)
+	}
+
+	return parts[1], parts[3], parts[4], nil
+}
+
 const nodeGroupResourceIDSeparator = ":"
 
 func NodeGroupCreateResourceID(clusterName, nodeGroupName string) string {
@@ -0,0 +1,130 @@
+package eks_test
+
+import (
+	"testing"
+
+	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
+)
+
+func TestIdentityProviderConfigParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectError         bool
+		ExpectedClusterName string
+		ExpectedConfigName  string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "cluster:config:extra",
+			ExpectError: true,
+		},
+		{
+			TestName:            "valid ID",
+			InputID:             tfeks.IdentityProviderConfigCreateResourceID("cluster", "config"),
+			ExpectedClusterName: "cluster",
+			ExpectedConfigName:  "config",
+		},
+		{
+			TestName:            "valid ARN",
+			InputID:             "arn:aws:eks:us-west-2:123456789012:identityproviderconfig/cluster/oidc/config/0123abcd-45ef-67ab-89cd-0123456789ef",
+			ExpectedClusterName: "cluster",
+			ExpectedConfigName:  "config",
+		},
+		{
+			TestName:    "ARN incorrect service",
+			InputID:     "arn:aws:ecs:us-west-2:123456789012:identityproviderconfig/cluster/oidc/config/0123abcd-45ef-67ab-89cd-0123456789ef",
+			ExpectError: true,
+		},
+		{
+			TestName:    "ARN incorrect resource type",
+			InputID:     "arn:aws:eks:us-west-2:123456789012:cluster/cluster",
+			ExpectError: true,
+		},
+		{
+			TestName:    "ARN missing UUID",
+			InputID:     "arn:aws:eks:us-west-2:123456789012:identityproviderconfig/cluster/oidc/config",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotClusterName, gotConfigName, err := tfeks.IdentityProviderConfigParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotClusterName != testCase.ExpectedClusterName {
+				t.Errorf("got cluster name %s, expected %s", gotClusterName, testCase.ExpectedClusterName)
+			}
+
+			if gotConfigName != testCase.ExpectedConfigName {
+				t.Errorf("got config name %s, expected %s", gotConfigName, testCase.ExpectedConfigName)
+			}
+		})
+	}
+}
+
+func TestIdentityProviderConfigIDFromARN(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		InputARN    string
+		ExpectError bool
+		ExpectedID  string
+	}{
+		{
+			TestName:    "empty ARN",
+			InputARN:    "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "resource ID",
+			InputARN:    tfeks.IdentityProviderConfigCreateResourceID("cluster", "config"),
+			ExpectError: true,
+		},
+		{
+			TestName:    "ARN missing UUID",
+			InputARN:    "arn:aws:eks:us-west-2:123456789012:identityproviderconfig/cluster/oidc/config/",
+			ExpectError: true,
+		},
+		{
+			TestName:   "valid ARN",
+			InputARN:   "arn:aws:eks:us-west-2:123456789012:identityproviderconfig/cluster/oidc/config/0123abcd-45ef-67ab-89cd-0123456789ef",
+			ExpectedID: "0123abcd-45ef-67ab-89cd-0123456789ef",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfeks.IdentityProviderConfigIDFromARN(testCase.InputARN)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package waiter
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
@@ -106,6 +108,69 @@ func NodegroupStatus(conn *eks.EKS, clusterName, nodeGroupName string) resource.
 	}
 }
 
+// NodegroupCapacityStatus returns the number of InService and Healthy instances in the node group's
+// Auto Scaling groups and whether that number has reached the required number of healthy nodes.
+func NodegroupCapacityStatus(ctx context.Context, conn *autoscaling.AutoScaling, autoScalingGroupNames []string, required int) resource.StateRefreshFunc {
+	return nodegroupCapacityStatus(func() ([]*autoscaling.Group, error) {
+		input := &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice(autoScalingGroupNames),
+		}
+		var output []*autoscaling.Group
+
+		err := conn.DescribeAutoScalingGroupsPagesWithContext(ctx, input, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			output = append(output, page.AutoScalingGroups...)
+
+			return !lastPage
+		})
+
+		return output, err
+	}, required)
+}
+
+func nodegroupCapacityStatus(describe func() ([]*autoscaling.Group, error), required int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		groups, err := describe()
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var healthy int
+
+		for _, group := range groups {
+			if group == nil {
+				continue
+			}
+
+			for _, instance := range group.Instances {
+				if instance == nil {
+					continue
+				}
+
+				if !strings.EqualFold(aws.StringValue(instance.LifecycleState), autoscaling.LifecycleStateInService) {
+					continue
+				}
+
+				if !strings.EqualFold(aws.StringValue(instance.HealthStatus), NodegroupCapacityInstanceHealthy) {
+					continue
+				}
+
+				healthy++
+			}
+		}
+
+		if healthy < required {
+			return healthy, NodegroupCapacityStatusPending, nil
+		}
+
+		return healthy, NodegroupCapacityStatusHealthy, nil
+	}
+}
+
 func NodegroupUpdateStatus(conn *eks.EKS, clusterName, nodeGroupName, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := finder.NodegroupUpdateByClusterNameNodegroupNameAndID(conn, clusterName, nodeGroupName, id)
@@ -2,9 +2,12 @@ package waiter
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
@@ -17,6 +20,14 @@ const (
 	AddonDeletedTimeout = 40 * time.Minute
 )
 
+const (
+	NodegroupCapacityStatusHealthy = "Healthy"
+	NodegroupCapacityStatusPending = "Pending"
+
+	// Auto Scaling instance health status reported once an instance passes its health checks
+	NodegroupCapacityInstanceHealthy = "Healthy"
+)
+
 func AddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
@@ -190,6 +201,29 @@ func NodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeGroup
 	return nil, err
 }
 
+// NodegroupCapacityHealthy waits until at least the required number of instances in the node group's
+// Auto Scaling groups are InService and Healthy.
+func NodegroupCapacityHealthy(ctx context.Context, conn *autoscaling.AutoScaling, autoScalingGroupNames []string, required int, timeout time.Duration) (int, error) {
+	return nodegroupCapacityHealthy(ctx, NodegroupCapacityStatus(ctx, conn, autoScalingGroupNames, required), timeout)
+}
+
+func nodegroupCapacityHealthy(ctx context.Context, refresh resource.StateRefreshFunc, timeout time.Duration) (int, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{NodegroupCapacityStatusPending},
+		Target:  []string{NodegroupCapacityStatusHealthy},
+		Refresh: statusLogged("EKS Node Group healthy capacity", refresh),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(int); ok {
+		return output, err
+	}
+
+	return 0, err
+}
+
 func NodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
@@ -236,7 +270,7 @@ func OidcIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clust
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusCreating},
 		Target:  []string{eks.ConfigStatusActive},
-		Refresh: OidcIdentityProviderConfigStatus(ctx, conn, clusterName, configName),
+		Refresh: statusLogged(fmt.Sprintf("EKS Identity Provider Config (%s/%s) association", clusterName, configName), OidcIdentityProviderConfigStatus(ctx, conn, clusterName, configName)),
 		Timeout: timeout,
 	}
 
@@ -253,7 +287,7 @@ func OidcIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, clust
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusActive, eks.ConfigStatusDeleting},
 		Target:  []string{},
-		Refresh: OidcIdentityProviderConfigStatus(ctx, conn, clusterName, configName),
+		Refresh: statusLogged(fmt.Sprintf("EKS Identity Provider Config (%s/%s) disassociation", clusterName, configName), OidcIdentityProviderConfigStatus(ctx, conn, clusterName, configName)),
 		Timeout: timeout,
 	}
 
@@ -265,3 +299,26 @@ func OidcIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, clust
 
 	return nil, err
 }
+
+// statusLogged wraps a StateRefreshFunc to log the status reported at each poll and the time elapsed since the first poll.
+func statusLogged(operation string, f resource.StateRefreshFunc) resource.StateRefreshFunc {
+	var start time.Time
+
+	return func() (interface{}, string, error) {
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		output, status, err := f()
+
+		if err != nil {
+			log.Printf("[DEBUG] Waiting for %s: error after %s: %s", operation, time.Since(start).Round(time.Second), err)
+		} else if output == nil {
+			log.Printf("[DEBUG] Waiting for %s: not found after %s", operation, time.Since(start).Round(time.Second))
+		} else {
+			log.Printf("[DEBUG] Waiting for %s: status %s after %s", operation, status, time.Since(start).Round(time.Second))
+		}
+
+		return output, status, err
+	}
+}
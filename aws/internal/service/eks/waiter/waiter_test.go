@@ -0,0 +1,156 @@
+package waiter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+func TestStatusLogged(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	testErr := errors.New("test error")
+	statuses := []string{eks.ConfigStatusCreating, eks.ConfigStatusActive, "", "error"}
+	var i int
+
+	f := statusLogged("test operation", func() (interface{}, string, error) {
+		status := statuses[i]
+		i++
+
+		switch status {
+		case "":
+			return nil, "", nil
+		case "error":
+			return nil, "", testErr
+		}
+
+		return struct{}{}, status, nil
+	})
+
+	for range statuses {
+		f()
+	}
+
+	got := buf.String()
+
+	for _, want := range []string{
+		"[DEBUG] Waiting for test operation: status CREATING after 0s",
+		"[DEBUG] Waiting for test operation: status ACTIVE after 0s",
+		"[DEBUG] Waiting for test operation: not found after 0s",
+		"[DEBUG] Waiting for test operation: error after 0s: test error",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected log output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestNodegroupCapacityStatus(t *testing.T) {
+	instance := func(lifecycleState, healthStatus string) *autoscaling.Instance {
+		return &autoscaling.Instance{
+			HealthStatus:   aws.String(healthStatus),
+			LifecycleState: aws.String(lifecycleState),
+		}
+	}
+
+	groups := []*autoscaling.Group{
+		{
+			Instances: []*autoscaling.Instance{
+				instance(autoscaling.LifecycleStateInService, "Healthy"),
+				instance(autoscaling.LifecycleStatePending, "Healthy"),
+				instance(autoscaling.LifecycleStateInService, "Unhealthy"),
+				nil,
+			},
+		},
+		nil,
+		{
+			Instances: []*autoscaling.Instance{
+				instance(autoscaling.LifecycleStateInService, "Healthy"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		Required       int
+		ExpectedStatus string
+	}{
+		{
+			Name:           "pending",
+			Required:       3,
+			ExpectedStatus: NodegroupCapacityStatusPending,
+		},
+		{
+			Name:           "healthy",
+			Required:       2,
+			ExpectedStatus: NodegroupCapacityStatusHealthy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, status, err := nodegroupCapacityStatus(func() ([]*autoscaling.Group, error) {
+				return groups, nil
+			}, testCase.Required)()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if status != testCase.ExpectedStatus {
+				t.Errorf("expected status %s, got %s", testCase.ExpectedStatus, status)
+			}
+
+			if healthy := output.(int); healthy != 2 {
+				t.Errorf("expected 2 healthy instances, got %d", healthy)
+			}
+		})
+	}
+}
+
+func TestNodegroupCapacityHealthy(t *testing.T) {
+	statuses := []string{NodegroupCapacityStatusPending, NodegroupCapacityStatusPending, NodegroupCapacityStatusHealthy}
+	var calls int
+
+	healthy, err := nodegroupCapacityHealthy(context.Background(), func() (interface{}, string, error) {
+		status := statuses[calls]
+		calls++
+
+		return calls, status, nil
+	}, 1*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != len(statuses) {
+		t.Errorf("expected %d calls, got %d", len(statuses), calls)
+	}
+
+	if healthy != len(statuses) {
+		t.Errorf("expected %d healthy instances, got %d", len(statuses), healthy)
+	}
+}
+
+func TestNodegroupCapacityHealthy_error(t *testing.T) {
+	testErr := errors.New("test error")
+
+	_, err := nodegroupCapacityHealthy(context.Background(), func() (interface{}, string, error) {
+		return nil, "", testErr
+	}, 1*time.Minute)
+
+	if !errors.Is(err, testErr) {
+		t.Fatalf("expected error %q, got %v", testErr, err)
+	}
+}
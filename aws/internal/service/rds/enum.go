@@ -7,8 +7,13 @@ const (
 )
 
 const (
-	EventSubscriptionStatusActive    = "active"
-	EventSubscriptionStatusCreating  = "creating"
-	EventSubscriptionStatusDeleting  = "deleting"
-	EventSubscriptionStatusModifying = "modifying"
+	EventSubscriptionStatusActive       = "active"
+	EventSubscriptionStatusCreating     = "creating"
+	EventSubscriptionStatusDeleting     = "deleting"
+	EventSubscriptionStatusModifying    = "modifying"
+	EventSubscriptionStatusNoPermission = "no-permission"
+)
+
+const (
+	ServicePrincipal = "rds.amazonaws.com"
 )
@@ -64,7 +64,57 @@ func DBProxyEndpoint(conn *rds.RDS, id string) (*rds.DBProxyEndpoint, error) {
 		return !lastPage
 	})
 
-	return dbProxyEndpoint, err
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dbProxyEndpoint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return dbProxyEndpoint, nil
+}
+
+// DBProxyEndpointByName returns the DBProxyEndpoint corresponding to the specified endpoint name.
+// If proxyName is set, only endpoints of that DB proxy are considered.
+func DBProxyEndpointByName(conn *rds.RDS, proxyName, name string) (*rds.DBProxyEndpoint, error) {
+	input := &rds.DescribeDBProxyEndpointsInput{
+		DBProxyEndpointName: aws.String(name),
+	}
+
+	if proxyName != "" {
+		input.DBProxyName = aws.String(proxyName)
+	}
+
+	output, err := conn.DescribeDBProxyEndpoints(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBProxyEndpoints) == 0 || output.DBProxyEndpoints[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBProxyEndpoints); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DBProxyEndpoints[0], nil
 }
 
 func DBClusterRoleByDBClusterIDAndRoleARN(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
@@ -160,3 +210,35 @@ func EventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription, er
 
 	return output.EventSubscriptionsList[0], nil
 }
+
+// EventCategoriesBySourceType returns the event categories available for the specified source type.
+// If no source type is specified, the event categories of all source types are returned.
+func EventCategoriesBySourceType(conn *rds.RDS, sourceType string) ([]string, error) {
+	input := &rds.DescribeEventCategoriesInput{}
+
+	if sourceType != "" {
+		input.SourceType = aws.String(sourceType)
+	}
+
+	output, err := conn.DescribeEventCategories(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var eventCategories []string
+
+	for _, v := range output.EventCategoriesMapList {
+		if v == nil {
+			continue
+		}
+
+		eventCategories = append(eventCategories, aws.StringValueSlice(v.EventCategories)...)
+	}
+
+	return eventCategories, nil
+}
@@ -1,6 +1,8 @@
 package waiter
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -9,16 +11,23 @@ import (
 )
 
 const (
-	// ProxyEndpoint NotFound
-	ProxyEndpointStatusNotFound = "NotFound"
-
 	// ProxyEndpoint Unknown
 	ProxyEndpointStatusUnknown = "Unknown"
 )
 
 func EventSubscriptionStatus(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return eventSubscriptionStatus(func() (*rds.EventSubscription, error) {
+		return finder.EventSubscriptionByID(conn, id)
+	}, EventSubscriptionThrottlingTimeout)
+}
+
+// eventSubscriptionStatus retries the describe call while it is throttled,
+// so that a StateChangeConf keeps polling instead of aborting the wait.
+func eventSubscriptionStatus(find func() (*rds.EventSubscription, error), throttlingTimeout time.Duration) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := finder.EventSubscriptionByID(conn, id)
+		outputRaw, err := tfresource.RetryWhenThrottled(throttlingTimeout, func() (interface{}, error) {
+			return find()
+		})
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -28,6 +37,8 @@ func EventSubscriptionStatus(conn *rds.RDS, id string) resource.StateRefreshFunc
 			return nil, "", err
 		}
 
+		output := outputRaw.(*rds.EventSubscription)
+
 		return output, aws.StringValue(output.Status), nil
 	}
 }
@@ -37,12 +48,12 @@ func DBProxyEndpointStatus(conn *rds.RDS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := finder.DBProxyEndpoint(conn, id)
 
-		if err != nil {
-			return nil, ProxyEndpointStatusUnknown, err
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
-		if output == nil {
-			return nil, ProxyEndpointStatusNotFound, nil
+		if err != nil {
+			return nil, ProxyEndpointStatusUnknown, err
 		}
 
 		return output, aws.StringValue(output.Status), nil
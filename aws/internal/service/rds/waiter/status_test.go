@@ -0,0 +1,59 @@
+package waiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+)
+
+func TestEventSubscriptionStatus(t *testing.T) {
+	t.Run("throttled then success", func(t *testing.T) {
+		var calls int
+
+		_, status, err := eventSubscriptionStatus(func() (*rds.EventSubscription, error) {
+			calls++
+
+			if calls == 1 {
+				return nil, awserr.New("Throttling", "Rate exceeded", nil)
+			}
+
+			return &rds.EventSubscription{Status: aws.String(tfrds.EventSubscriptionStatusActive)}, nil
+		}, 750*time.Millisecond)()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if status != tfrds.EventSubscriptionStatusActive {
+			t.Errorf("expected status %q, got %q", tfrds.EventSubscriptionStatusActive, status)
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("non-throttle error aborts", func(t *testing.T) {
+		testErr := errors.New("test error")
+		var calls int
+
+		_, _, err := eventSubscriptionStatus(func() (*rds.EventSubscription, error) {
+			calls++
+
+			return nil, testErr
+		}, 750*time.Millisecond)()
+
+		if !errors.Is(err, testErr) {
+			t.Fatalf("expected test error, got: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+}
@@ -1,11 +1,18 @@
 package waiter
 
 import (
+	"errors"
+	"fmt"
+	"log"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -13,6 +20,9 @@ const (
 
 	DBClusterRoleAssociationCreatedTimeout = 5 * time.Minute
 	DBClusterRoleAssociationDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to retry a throttled event subscription status read
+	EventSubscriptionThrottlingTimeout = 2 * time.Minute
 )
 
 func EventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -66,6 +76,11 @@ func EventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duration) (
 	outputRaw, err := stateConf.WaitForState()
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
+		// "no-permission" is terminal: RDS cannot publish to the SNS topic until its policy is fixed.
+		if aws.StringValue(output.Status) == tfrds.EventSubscriptionStatusNoPermission {
+			tfresource.SetLastError(err, fmt.Errorf("RDS does not have permission to publish to SNS topic (%s)", aws.StringValue(output.SnsTopicArn)))
+		}
+
 		return output, err
 	}
 
@@ -93,30 +108,74 @@ func DBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duration) (
 	return nil, err
 }
 
+// DBProxyEndpointModified waits for a DBProxyEndpoint to return Available after a modification
+func DBProxyEndpointModified(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rds.DBProxyEndpointStatusModifying},
+		Target:  []string{rds.DBProxyEndpointStatusAvailable},
+		Refresh: DBProxyEndpointStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // DBProxyEndpointDeleted waits for a DBProxyEndpoint to return Deleted
 func DBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) {
+	return dbProxyEndpointDeleted(DBProxyEndpointStatus(conn, id), timeout)
+}
+
+// dbProxyEndpointDeleted waits until the refresh function no longer finds the DBProxyEndpoint.
+// Throttling and server-side describe errors are retried, while any other error, or an endpoint
+// that settles in a status other than deleting, is reported as a terminal error.
+func dbProxyEndpointDeleted(refresh resource.StateRefreshFunc, timeout time.Duration) (*rds.DBProxyEndpoint, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{rds.DBProxyEndpointStatusDeleting},
+		Pending: []string{rds.DBProxyEndpointStatusDeleting, ProxyEndpointStatusUnknown},
 		Target:  []string{},
-		Refresh: DBProxyEndpointStatus(conn, id),
+		Refresh: func() (interface{}, string, error) {
+			output, status, err := refresh()
+
+			var reqErr awserr.RequestFailure
+			if request.IsErrorThrottle(err) || (errors.As(err, &reqErr) && reqErr.StatusCode() >= 500) {
+				log.Printf("[WARN] Error reading RDS DB Proxy Endpoint status, retrying: %s", err)
+				return &rds.DBProxyEndpoint{}, ProxyEndpointStatusUnknown, nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			return output, status, nil
+		},
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
+		switch status := aws.StringValue(output.Status); status {
+		case rds.DBProxyEndpointStatusAvailable, rds.DBProxyEndpointStatusIncompatibleNetwork:
+			tfresource.SetLastError(err, fmt.Errorf("deletion did not complete, endpoint status is %s (the endpoint may still be in use)", status))
+		}
+
 		return output, err
 	}
 
 	return nil, err
 }
 
-func DBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+func DBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string, timeout time.Duration) (*rds.DBClusterRole, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{tfrds.DBClusterRoleStatusPending},
 		Target:  []string{tfrds.DBClusterRoleStatusActive},
 		Refresh: DBClusterRoleStatus(conn, dbClusterID, roleARN),
-		Timeout: DBClusterRoleAssociationCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -128,12 +187,17 @@ func DBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string)
 	return nil, err
 }
 
-func DBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+func DBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string, timeout time.Duration) (*rds.DBClusterRole, error) {
+	return dbClusterRoleAssociationDeleted(DBClusterRoleStatus(conn, dbClusterID, roleARN), timeout)
+}
+
+// dbClusterRoleAssociationDeleted waits until the refresh function no longer finds the DB cluster role.
+func dbClusterRoleAssociationDeleted(refresh resource.StateRefreshFunc, timeout time.Duration) (*rds.DBClusterRole, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{tfrds.DBClusterRoleStatusActive, tfrds.DBClusterRoleStatusPending},
 		Target:  []string{},
-		Refresh: DBClusterRoleStatus(conn, dbClusterID, roleARN),
-		Timeout: DBClusterRoleAssociationDeletedTimeout,
+		Refresh: refresh,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
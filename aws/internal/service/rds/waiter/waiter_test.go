@@ -0,0 +1,130 @@
+package waiter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+)
+
+func TestDBClusterRoleAssociationDeleted(t *testing.T) {
+	t.Run("role gone", func(t *testing.T) {
+		var calls int
+
+		start := time.Now()
+		_, err := dbClusterRoleAssociationDeleted(func() (interface{}, string, error) {
+			calls++
+
+			if calls == 1 {
+				return &rds.DBClusterRole{Status: aws.String(tfrds.DBClusterRoleStatusActive)}, tfrds.DBClusterRoleStatusActive, nil
+			}
+
+			return nil, "", nil
+		}, 1*time.Minute)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+
+		if elapsed := time.Since(start); elapsed > 30*time.Second {
+			t.Errorf("expected waiter to return promptly, took %s", elapsed)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := dbClusterRoleAssociationDeleted(func() (interface{}, string, error) {
+			return &rds.DBClusterRole{Status: aws.String(tfrds.DBClusterRoleStatusActive)}, tfrds.DBClusterRoleStatusActive, nil
+		}, 1*time.Second)
+
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testErr := errors.New("test error")
+
+		_, err := dbClusterRoleAssociationDeleted(func() (interface{}, string, error) {
+			return nil, "", testErr
+		}, 1*time.Minute)
+
+		if !errors.Is(err, testErr) {
+			t.Fatalf("expected test error, got: %v", err)
+		}
+	})
+}
+
+func TestDBProxyEndpointDeleted(t *testing.T) {
+	t.Run("deleted after transient error", func(t *testing.T) {
+		var calls int
+
+		_, err := dbProxyEndpointDeleted(func() (interface{}, string, error) {
+			calls++
+
+			switch calls {
+			case 1:
+				return &rds.DBProxyEndpoint{Status: aws.String(rds.DBProxyEndpointStatusDeleting)}, rds.DBProxyEndpointStatusDeleting, nil
+			case 2:
+				return nil, ProxyEndpointStatusUnknown, awserr.New("Throttling", "Rate exceeded", nil)
+			case 3:
+				return nil, ProxyEndpointStatusUnknown, awserr.NewRequestFailure(awserr.New("InternalFailure", "internal failure", nil), 500, "")
+			}
+
+			return nil, "", nil
+		}, 1*time.Minute)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if calls != 4 {
+			t.Errorf("expected 4 calls, got %d", calls)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testErr := awserr.NewRequestFailure(awserr.New("AccessDenied", "access denied", nil), 403, "")
+		var calls int
+
+		_, err := dbProxyEndpointDeleted(func() (interface{}, string, error) {
+			calls++
+
+			return nil, ProxyEndpointStatusUnknown, testErr
+		}, 1*time.Minute)
+
+		if !errors.Is(err, testErr) {
+			t.Fatalf("expected test error, got: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	for _, status := range []string{rds.DBProxyEndpointStatusAvailable, rds.DBProxyEndpointStatusIncompatibleNetwork} {
+		status := status
+
+		t.Run(status, func(t *testing.T) {
+			_, err := dbProxyEndpointDeleted(func() (interface{}, string, error) {
+				return &rds.DBProxyEndpoint{Status: aws.String(status)}, status, nil
+			}, 1*time.Minute)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if want := "deletion did not complete, endpoint status is " + status; !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error containing %q, got: %s", want, err)
+			}
+		})
+	}
+}
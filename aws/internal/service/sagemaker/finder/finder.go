@@ -325,6 +325,79 @@ func EndpointConfigByName(conn *sagemaker.SageMaker, name string) (*sagemaker.De
 	return output, nil
 }
 
+// EndpointConfigNamesByModelName returns the names of the endpoint configurations with a production variant
+// that references the specified model.
+func EndpointConfigNamesByModelName(conn *sagemaker.SageMaker, modelName string) ([]string, error) {
+	var names []string
+
+	err := conn.ListEndpointConfigsPages(&sagemaker.ListEndpointConfigsInput{}, func(page *sagemaker.ListEndpointConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EndpointConfigs {
+			if v != nil {
+				names = append(names, aws.StringValue(v.EndpointConfigName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []string
+
+	for _, name := range names {
+		endpointConfig, err := EndpointConfigByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, variant := range endpointConfig.ProductionVariants {
+			if variant != nil && aws.StringValue(variant.ModelName) == modelName {
+				output = append(output, name)
+				break
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// ModelByName returns the model corresponding to the specified name.
+func ModelByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelOutput, error) {
+	input := &sagemaker.DescribeModelInput{
+		ModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModel(input)
+
+	if tfawserr.ErrMessageContains(err, tfsagemaker.ErrCodeValidationException, "Could not find model") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FlowDefinitionByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeFlowDefinitionOutput, error) {
 	input := &sagemaker.DescribeFlowDefinitionInput{
 		FlowDefinitionName: aws.String(name),
@@ -13,3 +13,8 @@ func SecurityPolicyName_Values() []string {
 		SecurityPolicyNameFIPS_2020_06,
 	}
 }
+
+const (
+	// PassiveIpAuto lets the server determine its passive mode IP address.
+	PassiveIpAuto = "AUTO"
+)
@@ -1,6 +1,8 @@
 package waiter
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,8 +15,18 @@ const (
 )
 
 func ServerState(conn *transfer.Transfer, id string) resource.StateRefreshFunc {
+	return serverState(func() (*transfer.DescribedServer, error) {
+		return finder.ServerByID(conn, id)
+	}, ServerThrottlingTimeout)
+}
+
+// serverState retries the describe call while it is throttled,
+// so that a StateChangeConf keeps polling instead of aborting the wait.
+func serverState(find func() (*transfer.DescribedServer, error), throttlingTimeout time.Duration) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := finder.ServerByID(conn, id)
+		outputRaw, err := tfresource.RetryWhenThrottled(throttlingTimeout, func() (interface{}, error) {
+			return find()
+		})
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -24,6 +36,8 @@ func ServerState(conn *transfer.Transfer, id string) resource.StateRefreshFunc {
 			return nil, "", err
 		}
 
+		output := outputRaw.(*transfer.DescribedServer)
+
 		return output, aws.StringValue(output.State), nil
 	}
 }
@@ -0,0 +1,66 @@
+package waiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+func TestServerState(t *testing.T) {
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name          string
+		Errors        []error
+		ExpectedCalls int
+		ExpectedState string
+		ExpectError   bool
+	}{
+		{
+			Name:          "throttled then success",
+			Errors:        []error{awserr.New(transfer.ErrCodeThrottlingException, "Rate exceeded", nil)},
+			ExpectedCalls: 2,
+			ExpectedState: transfer.StateOnline,
+		},
+		{
+			Name:          "non-throttle error aborts",
+			Errors:        []error{testErr},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var calls int
+
+			_, state, err := serverState(func() (*transfer.DescribedServer, error) {
+				calls++
+
+				if calls <= len(testCase.Errors) {
+					return nil, testCase.Errors[calls-1]
+				}
+
+				return &transfer.DescribedServer{State: aws.String(transfer.StateOnline)}, nil
+			}, 750*time.Millisecond)()
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if state != testCase.ExpectedState {
+				t.Errorf("expected state %q, got %q", testCase.ExpectedState, state)
+			}
+
+			if calls != testCase.ExpectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.ExpectedCalls, calls)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package waiter
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/transfer"
@@ -10,6 +11,12 @@ import (
 const (
 	ServerDeletedTimeout = 10 * time.Minute
 	UserDeletedTimeout   = 10 * time.Minute
+
+	// Maximum amount of time a server may remain OFFLINE after an endpoint change before it is considered failed
+	ServerEndpointUpdatedOfflineTimeout = 5 * time.Minute
+
+	// Maximum amount of time to retry a throttled server state read
+	ServerThrottlingTimeout = 2 * time.Minute
 )
 
 func ServerCreated(conn *transfer.Transfer, id string, timeout time.Duration) (*transfer.DescribedServer, error) {
@@ -46,6 +53,47 @@ func ServerDeleted(conn *transfer.Transfer, id string) (*transfer.DescribedServe
 	return nil, err
 }
 
+// ServerEndpointUpdated waits for a server to return to ONLINE after an endpoint change.
+func ServerEndpointUpdated(conn *transfer.Transfer, id string, timeout time.Duration) (*transfer.DescribedServer, error) {
+	return serverEndpointUpdated(ServerState(conn, id), timeout, ServerEndpointUpdatedOfflineTimeout)
+}
+
+func serverEndpointUpdated(refresh resource.StateRefreshFunc, timeout, offlineTimeout time.Duration) (*transfer.DescribedServer, error) {
+	var offlineSince time.Time
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transfer.StateOffline, transfer.StateStarting},
+		Target:  []string{transfer.StateOnline},
+		Refresh: func() (interface{}, string, error) {
+			output, state, err := refresh()
+
+			if err != nil || state != transfer.StateOffline {
+				offlineSince = time.Time{}
+
+				return output, state, err
+			}
+
+			// The server passes through OFFLINE while the endpoint is changed, but should not remain there.
+			if offlineSince.IsZero() {
+				offlineSince = time.Now()
+			} else if elapsed := time.Since(offlineSince); elapsed > offlineTimeout {
+				return output, state, fmt.Errorf("server has been %s for %s after endpoint update", state, elapsed.Round(time.Second))
+			}
+
+			return output, state, nil
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func ServerStarted(conn *transfer.Transfer, id string, timeout time.Duration) (*transfer.DescribedServer, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{transfer.StateStarting, transfer.StateOffline, transfer.StateStopping},
@@ -81,11 +129,15 @@ func ServerStopped(conn *transfer.Transfer, id string, timeout time.Duration) (*
 }
 
 func UserDeleted(conn *transfer.Transfer, serverID, userName string) (*transfer.DescribedUser, error) {
+	return userDeleted(UserState(conn, serverID, userName), UserDeletedTimeout)
+}
+
+func userDeleted(refresh resource.StateRefreshFunc, timeout time.Duration) (*transfer.DescribedUser, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{userStateExists},
 		Target:  []string{},
-		Refresh: UserState(conn, serverID, userName),
-		Timeout: UserDeletedTimeout,
+		Refresh: refresh,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -0,0 +1,92 @@
+package waiter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+func TestServerEndpointUpdated(t *testing.T) {
+	t.Run("public to vpc", func(t *testing.T) {
+		states := []string{
+			transfer.StateOffline,
+			transfer.StateOffline,
+			transfer.StateStarting,
+			transfer.StateOnline,
+		}
+		var calls int
+
+		output, err := serverEndpointUpdated(func() (interface{}, string, error) {
+			state := states[calls]
+			calls++
+
+			return &transfer.DescribedServer{
+				EndpointType: aws.String(transfer.EndpointTypeVpc),
+				State:        aws.String(state),
+			}, state, nil
+		}, 1*time.Minute, 1*time.Minute)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if calls != len(states) {
+			t.Errorf("expected %d calls, got %d", len(states), calls)
+		}
+
+		if got, want := aws.StringValue(output.EndpointType), transfer.EndpointTypeVpc; got != want {
+			t.Errorf("expected endpoint type %s, got %s", want, got)
+		}
+	})
+
+	t.Run("prolonged offline", func(t *testing.T) {
+		_, err := serverEndpointUpdated(func() (interface{}, string, error) {
+			return &transfer.DescribedServer{State: aws.String(transfer.StateOffline)}, transfer.StateOffline, nil
+		}, 1*time.Minute, 1*time.Second)
+
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		if !strings.Contains(err.Error(), "server has been OFFLINE") {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+}
+
+func TestUserDeleted(t *testing.T) {
+	t.Run("user gone", func(t *testing.T) {
+		var calls int
+
+		_, err := userDeleted(func() (interface{}, string, error) {
+			calls++
+
+			if calls < 3 {
+				return &transfer.DescribedUser{UserName: aws.String("test")}, userStateExists, nil
+			}
+
+			return nil, "", nil
+		}, 1*time.Minute)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := userDeleted(func() (interface{}, string, error) {
+			return &transfer.DescribedUser{UserName: aws.String("test")}, userStateExists, nil
+		}, 1*time.Second)
+
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -38,6 +38,21 @@ func TestNotFound(t *testing.T) {
 			Err:      fmt.Errorf("test: %w", &resource.NotFoundError{LastError: errors.New("test")}),
 			Expected: true,
 		},
+		{
+			Name:     "empty result error",
+			Err:      tfresource.NewEmptyResultError(nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped empty result error",
+			Err:      fmt.Errorf("test: %w", tfresource.NewEmptyResultError(nil)),
+			Expected: true,
+		},
+		{
+			Name:     "too many results error",
+			Err:      tfresource.NewTooManyResultsError(2, nil),
+			Expected: true,
+		},
 	}
 
 	for _, testCase := range testCases {
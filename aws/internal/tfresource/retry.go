@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -71,6 +72,26 @@ func RetryWhenAwsErrCodeEquals(timeout time.Duration, f func() (interface{}, err
 	return RetryWhenAwsErrCodeEqualsContext(context.Background(), timeout, f, codes...)
 }
 
+// RetryWhenThrottledContext retries the specified function when it returns an AWS throttling error,
+// e.g. Throttling, ThrottlingException or RequestLimitExceeded.
+// `f` is retried with exponential backoff until `timeout` expires.
+func RetryWhenThrottledContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if request.IsErrorThrottle(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenThrottled retries the specified function when it returns an AWS throttling error,
+// e.g. Throttling, ThrottlingException or RequestLimitExceeded.
+// `f` is retried with exponential backoff until `timeout` expires.
+func RetryWhenThrottled(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenThrottledContext(context.Background(), timeout, f)
+}
+
 // RetryWhenNotFoundContext retries the specified function when it returns a resource.NotFoundError.
 func RetryWhenNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
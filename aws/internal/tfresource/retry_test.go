@@ -75,6 +75,78 @@ func TestRetryWhenAwsErrCodeEquals(t *testing.T) {
 	}
 }
 
+func TestRetryWhenThrottled(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("Testing", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "throttling error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("ThrottlingException", "Rate exceeded", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "throttling error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("Throttling", "Rate exceeded", nil)
+				}
+
+				return nil, nil
+			},
+		},
+		{
+			Name: "request limit exceeded success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("RequestLimitExceeded", "Request limit exceeded", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenThrottled(1*time.Second, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestRetryWhenNewResourceNotFound(t *testing.T) {
 	var retryCount int32
 
@@ -187,6 +187,13 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"sagemaker_default_execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSagemakerExecutionRoleArn,
+				Description:  descriptions["sagemaker_default_execution_role_arn"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -245,6 +252,7 @@ func Provider() *schema.Provider {
 			"aws_db_event_categories":                        dataSourceAwsDbEventCategories(),
 			"aws_db_instance":                                dataSourceAwsDbInstance(),
 			"aws_db_proxy":                                   dataSourceAwsDbProxy(),
+			"aws_db_proxy_endpoint":                          dataSourceAwsDbProxyEndpoint(),
 			"aws_db_snapshot":                                dataSourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                            dataSourceAwsDbSubnetGroup(),
 			"aws_directory_service_directory":                dataSourceAwsDirectoryServiceDirectory(),
@@ -297,6 +305,7 @@ func Provider() *schema.Provider {
 			"aws_efs_mount_target":                           dataSourceAwsEfsMountTarget(),
 			"aws_eip":                                        dataSourceAwsEip(),
 			"aws_eks_addon":                                  dataSourceAwsEksAddon(),
+			"aws_eks_addon_version":                          dataSourceAwsEksAddonVersion(),
 			"aws_eks_cluster":                                dataSourceAwsEksCluster(),
 			"aws_eks_clusters":                               dataSourceAwsEksClusters(),
 			"aws_eks_cluster_auth":                           dataSourceAwsEksClusterAuth(),
@@ -441,6 +450,7 @@ func Provider() *schema.Provider {
 			"aws_subnets":                                    dataSourceAwsSubnets(),
 			"aws_subnet_ids":                                 dataSourceAwsSubnetIDs(),
 			"aws_transfer_server":                            dataSourceAwsTransferServer(),
+			"aws_transfer_user":                              dataSourceAwsTransferUser(),
 			"aws_vpcs":                                       dataSourceAwsVpcs(),
 			"aws_security_group":                             dataSourceAwsSecurityGroup(),
 			"aws_security_groups":                            dataSourceAwsSecurityGroups(),
@@ -1365,6 +1375,9 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"sagemaker_default_execution_role_arn": "The ARN of the IAM role used by SageMaker models\n" +
+			"that do not set execution_role_arn.",
 	}
 
 	endpointServiceNames = []string{
@@ -1554,6 +1567,8 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
 		terraformVersion:        terraformVersion,
+
+		SagemakerDefaultExecutionRoleArn: d.Get("sagemaker_default_execution_role_arn").(string),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,20 +9,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appmesh/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appmesh/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsAppmeshMesh() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsAppmeshMeshCreate,
-		Read:   resourceAwsAppmeshMeshRead,
-		Update: resourceAwsAppmeshMeshUpdate,
-		Delete: resourceAwsAppmeshMeshDelete,
+		Create:        resourceAwsAppmeshMeshCreate,
+		Read:          resourceAwsAppmeshMeshRead,
+		UpdateContext: resourceAwsAppmeshMeshUpdate,
+		Delete:        resourceAwsAppmeshMeshDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -50,13 +54,10 @@ func resourceAwsAppmeshMesh() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  appmesh.EgressFilterTypeDropAll,
-										ValidateFunc: validation.StringInSlice([]string{
-											appmesh.EgressFilterTypeAllowAll,
-											appmesh.EgressFilterTypeDropAll,
-										}, false),
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      appmesh.EgressFilterTypeDropAll,
+										ValidateFunc: validateAppmeshMeshEgressFilterType,
 									},
 								},
 							},
@@ -212,9 +213,11 @@ func resourceAwsAppmeshMeshRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAwsAppmeshMeshUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsAppmeshMeshUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).appmeshconn
 
+	var diags diag.Diagnostics
+
 	if d.HasChange("spec") {
 		_, v := d.GetChange("spec")
 		req := &appmesh.UpdateMeshInput{
@@ -225,7 +228,11 @@ func resourceAwsAppmeshMeshUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updating App Mesh service mesh: %#v", req)
 		_, err := conn.UpdateMesh(req)
 		if err != nil {
-			return fmt.Errorf("error updating App Mesh service mesh: %s", err)
+			return diag.Errorf("error updating App Mesh service mesh: %s", err)
+		}
+
+		if d.HasChange("spec.0.egress_filter.0.type") && d.Get("spec.0.egress_filter.0.type").(string) == appmesh.EgressFilterTypeDropAll {
+			diags = append(diags, appmeshMeshEgressFilterDropAllDiags(conn, d.Id(), d.Get("mesh_owner").(string))...)
 		}
 	}
 
@@ -234,11 +241,15 @@ func resourceAwsAppmeshMeshUpdate(d *schema.ResourceData, meta interface{}) erro
 		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.AppmeshUpdateTags(conn, arn, o, n); err != nil {
-			return fmt.Errorf("error updating App Mesh service mesh (%s) tags: %s", arn, err)
+			return diag.Errorf("error updating App Mesh service mesh (%s) tags: %s", arn, err)
 		}
 	}
 
-	return resourceAwsAppmeshMeshRead(d, meta)
+	if err := resourceAwsAppmeshMeshRead(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 func resourceAwsAppmeshMeshDelete(d *schema.ResourceData, meta interface{}) error {
@@ -257,3 +268,27 @@ func resourceAwsAppmeshMeshDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// appmeshMeshEgressFilterDropAllDiags warns when a mesh without virtual gateways has been changed
+// to DROP_ALL egress, as that typically breaks ingress into the mesh.
+func appmeshMeshEgressFilterDropAllDiags(conn *appmesh.AppMesh, meshName, meshOwner string) diag.Diagnostics {
+	names, err := finder.VirtualGatewayNamesByMeshName(conn, meshName, meshOwner)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to list App Mesh service mesh (%s) virtual gateways: %s", meshName, err)
+		return nil
+	}
+
+	if len(names) > 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("App Mesh service mesh (%s) egress_filter type is %s without any virtual gateways", meshName, appmesh.EgressFilterTypeDropAll),
+			Detail:        "Ingress into the mesh is likely to break until a virtual gateway is added.",
+			AttributePath: cty.GetAttrPath("spec").IndexInt(0).GetAttr("egress_filter").IndexInt(0).GetAttr("type"),
+		},
+	}
+}
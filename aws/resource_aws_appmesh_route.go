@@ -232,8 +232,9 @@ func resourceAwsAppmeshRoute() *schema.Resource {
 												},
 
 												"max_retries": {
-													Type:     schema.TypeInt,
-													Required: true,
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(0),
 												},
 
 												"per_retry_timeout": {
@@ -608,8 +609,9 @@ func appmeshRouteHttpRouteSchema() *schema.Schema {
 							},
 
 							"max_retries": {
-								Type:     schema.TypeInt,
-								Required: true,
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(0),
 							},
 
 							"per_retry_timeout": {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -1171,6 +1172,31 @@ func testAccAwsAppmeshRoute_httpHeader(t *testing.T) {
 	})
 }
 
+func testAccAwsAppmeshRoute_retryPolicyInvalid(t *testing.T) {
+	meshName := acctest.RandomWithPrefix("tf-acc-test")
+	vrName := acctest.RandomWithPrefix("tf-acc-test")
+	vn1Name := acctest.RandomWithPrefix("tf-acc-test")
+	vn2Name := acctest.RandomWithPrefix("tf-acc-test")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appmesh.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppmeshRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsAppmeshRouteConfig_retryPolicyMaxRetries(meshName, vrName, vn1Name, vn2Name, rName, "grpc", "grpc_retry_events = [\"unavailable\"]", -1),
+				ExpectError: regexp.MustCompile(`expected spec.0.grpc_route.0.retry_policy.0.max_retries to be at least \(0\)`),
+			},
+			{
+				Config:      testAccAwsAppmeshRouteConfig_retryPolicyMaxRetries(meshName, vrName, vn1Name, vn2Name, rName, "http", "http_retry_events = [\"server-error\"]", -1),
+				ExpectError: regexp.MustCompile(`expected spec.0.http_route.0.retry_policy.0.max_retries to be at least \(0\)`),
+			},
+		},
+	})
+}
+
 func testAccAwsAppmeshRoute_routePriority(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
@@ -2411,3 +2437,38 @@ resource "aws_appmesh_route" "test" {
 }
 `, rName))
 }
+
+func testAccAwsAppmeshRouteConfig_retryPolicyMaxRetries(meshName, vrName, vn1Name, vn2Name, rName, routeType, retryEvents string, maxRetries int) string {
+	return composeConfig(testAccAppmeshRouteConfigBase(meshName, vrName, routeType, vn1Name, vn2Name), fmt.Sprintf(`
+resource "aws_appmesh_route" "test" {
+  name                = %[1]q
+  mesh_name           = aws_appmesh_mesh.test.id
+  virtual_router_name = aws_appmesh_virtual_router.test.name
+
+  spec {
+    %[2]s_route {
+      match {
+        prefix = "/"
+      }
+
+      action {
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.foo.name
+          weight       = 100
+        }
+      }
+
+      retry_policy {
+        %[3]s
+        max_retries = %[4]d
+
+        per_retry_timeout {
+          unit  = "s"
+          value = 15
+        }
+      }
+    }
+  }
+}
+`, rName, routeType, retryEvents, maxRetries))
+}
@@ -29,6 +29,7 @@ func TestAccAWSAppmesh_serial(t *testing.T) {
 			"httpRetryPolicy":     testAccAwsAppmeshRoute_httpRetryPolicy,
 			"httpRoute":           testAccAwsAppmeshRoute_httpRoute,
 			"httpRouteTimeout":    testAccAwsAppmeshRoute_httpRouteTimeout,
+			"retryPolicyInvalid":  testAccAwsAppmeshRoute_retryPolicyInvalid,
 			"routePriority":       testAccAwsAppmeshRoute_routePriority,
 			"tcpRoute":            testAccAwsAppmeshRoute_tcpRoute,
 			"tcpRouteTimeout":     testAccAwsAppmeshRoute_tcpRouteTimeout,
@@ -58,6 +59,7 @@ func TestAccAWSAppmesh_serial(t *testing.T) {
 			"listenerOutlierDetection":   testAccAwsAppmeshVirtualNode_listenerOutlierDetection,
 			"listenerHealthChecks":       testAccAwsAppmeshVirtualNode_listenerHealthChecks,
 			"listenerTimeout":            testAccAwsAppmeshVirtualNode_listenerTimeout,
+			"listenerTimeoutProtocols":   testAccAwsAppmeshVirtualNode_listenerTimeoutProtocols,
 			"listenerTls":                testAccAwsAppmeshVirtualNode_listenerTls,
 			"listenerValidation":         testAccAwsAppmeshVirtualNode_listenerValidation,
 			"logging":                    testAccAwsAppmeshVirtualNode_logging,
@@ -68,9 +70,13 @@ func TestAccAWSAppmesh_serial(t *testing.T) {
 			"tags":  testAccAwsAppmeshVirtualRouter_tags,
 		},
 		"VirtualService": {
-			"virtualNode":   testAccAwsAppmeshVirtualService_virtualNode,
-			"virtualRouter": testAccAwsAppmeshVirtualService_virtualRouter,
-			"tags":          testAccAwsAppmeshVirtualService_tags,
+			"disappears":     testAccAwsAppmeshVirtualService_disappears,
+			"virtualNode":    testAccAwsAppmeshVirtualService_virtualNode,
+			"virtualRouter":  testAccAwsAppmeshVirtualService_virtualRouter,
+			"emptyProvider":  testAccAwsAppmeshVirtualService_emptyProvider,
+			"providerSwitch": testAccAwsAppmeshVirtualService_providerSwitch,
+			"sharedMesh":     testAccAwsAppmeshVirtualService_sharedMesh,
+			"tags":           testAccAwsAppmeshVirtualService_tags,
 		},
 	}
 
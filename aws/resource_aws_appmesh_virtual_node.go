@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -874,7 +876,11 @@ func resourceAwsAppmeshVirtualNode() *schema.Resource {
 			"tags_all": tagsSchemaComputed(),
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsAppmeshVirtualNodeListenerTimeoutCustomizeDiff,
+			resourceAwsAppmeshVirtualNodeClientPolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -1256,3 +1262,127 @@ func resourceAwsAppmeshVirtualNodeImport(d *schema.ResourceData, meta interface{
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceAwsAppmeshVirtualNodeListenerTimeoutCustomizeDiff ensures that listener timeouts are only
+// configured for the protocol of the listener's port mapping.
+func resourceAwsAppmeshVirtualNodeListenerTimeoutCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, vListener := range diff.Get("spec.0.listener").([]interface{}) {
+		mListener, ok := vListener.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validateAppmeshListenerTimeoutProtocol(mListener); err != nil {
+			return fmt.Errorf("spec.0.listener.%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAppmeshListenerTimeoutProtocol returns an error if the listener has a timeout
+// configured for a protocol other than that of its port mapping.
+func validateAppmeshListenerTimeoutProtocol(mListener map[string]interface{}) error {
+	var protocol string
+
+	if vPortMapping, ok := mListener["port_mapping"].([]interface{}); ok && len(vPortMapping) > 0 && vPortMapping[0] != nil {
+		protocol, _ = vPortMapping[0].(map[string]interface{})["protocol"].(string)
+	}
+
+	if protocol == "" {
+		return nil
+	}
+
+	vTimeout, ok := mListener["timeout"].([]interface{})
+
+	if !ok || len(vTimeout) == 0 || vTimeout[0] == nil {
+		return nil
+	}
+
+	for timeoutProtocol, v := range vTimeout[0].(map[string]interface{}) {
+		if v, ok := v.([]interface{}); !ok || len(v) == 0 {
+			continue
+		}
+
+		if timeoutProtocol != protocol {
+			return fmt.Errorf("timeout.0.%s cannot be configured for a listener with protocol %q", timeoutProtocol, protocol)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsAppmeshVirtualNodeClientPolicyCustomizeDiff ensures that each backend client policy
+// TLS validation specifies exactly one trust type. ExactlyOneOf cannot address blocks nested within the backend set.
+func resourceAwsAppmeshVirtualNodeClientPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.Get("spec.0.backend").(*schema.Set); ok {
+		for _, vBackend := range v.List() {
+			mBackend, ok := vBackend.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			vVirtualService, ok := mBackend["virtual_service"].([]interface{})
+
+			if !ok || len(vVirtualService) == 0 || vVirtualService[0] == nil {
+				continue
+			}
+
+			mVirtualService := vVirtualService[0].(map[string]interface{})
+
+			if err := validateAppmeshClientPolicyTrust(mVirtualService["client_policy"].([]interface{})); err != nil {
+				return fmt.Errorf("spec.0.backend (%s): %w", mVirtualService["virtual_service_name"], err)
+			}
+		}
+	}
+
+	if v, ok := diff.Get("spec.0.backend_defaults.0.client_policy").([]interface{}); ok {
+		if err := validateAppmeshClientPolicyTrust(v); err != nil {
+			return fmt.Errorf("spec.0.backend_defaults: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateAppmeshClientPolicyTrust returns an error if a client policy's TLS validation
+// does not specify exactly one of the acm, file or sds trust types.
+func validateAppmeshClientPolicyTrust(vClientPolicy []interface{}) error {
+	if len(vClientPolicy) == 0 || vClientPolicy[0] == nil {
+		return nil
+	}
+
+	vTls, ok := vClientPolicy[0].(map[string]interface{})["tls"].([]interface{})
+
+	if !ok || len(vTls) == 0 || vTls[0] == nil {
+		return nil
+	}
+
+	vValidation, ok := vTls[0].(map[string]interface{})["validation"].([]interface{})
+
+	if !ok || len(vValidation) == 0 || vValidation[0] == nil {
+		return nil
+	}
+
+	vTrust, ok := vValidation[0].(map[string]interface{})["trust"].([]interface{})
+
+	if !ok || len(vTrust) == 0 || vTrust[0] == nil {
+		return fmt.Errorf("client_policy.0.tls.0.validation.0.trust: exactly one of acm, file or sds must be specified")
+	}
+
+	var trustTypes []string
+
+	for _, trustType := range []string{"acm", "file", "sds"} {
+		if v, ok := vTrust[0].(map[string]interface{})[trustType].([]interface{}); ok && len(v) > 0 {
+			trustTypes = append(trustTypes, trustType)
+		}
+	}
+
+	if len(trustTypes) != 1 {
+		return fmt.Errorf("client_policy.0.tls.0.validation.0.trust: exactly one of acm, file or sds must be specified, got %d", len(trustTypes))
+	}
+
+	return nil
+}
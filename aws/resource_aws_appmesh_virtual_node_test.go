@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,9 +12,167 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestValidateAppmeshListenerTimeoutProtocol(t *testing.T) {
+	listener := func(protocol string, timeouts map[string]interface{}) map[string]interface{} {
+		mTimeout := map[string]interface{}{
+			"grpc":  []interface{}{},
+			"http":  []interface{}{},
+			"http2": []interface{}{},
+			"tcp":   []interface{}{},
+		}
+		for k, v := range timeouts {
+			mTimeout[k] = v
+		}
+
+		return map[string]interface{}{
+			"port_mapping": []interface{}{map[string]interface{}{"port": 8080, "protocol": protocol}},
+			"timeout":      []interface{}{mTimeout},
+		}
+	}
+	timeout := []interface{}{map[string]interface{}{"idle": []interface{}{map[string]interface{}{"unit": "s", "value": 10}}}}
+
+	testCases := []struct {
+		Name        string
+		Listener    map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name:     "no timeout",
+			Listener: map[string]interface{}{"port_mapping": []interface{}{map[string]interface{}{"port": 8080, "protocol": "http"}}},
+		},
+		{
+			Name:     "empty timeout",
+			Listener: listener("http", nil),
+		},
+		{
+			Name:     "grpc",
+			Listener: listener("grpc", map[string]interface{}{"grpc": timeout}),
+		},
+		{
+			Name:     "http",
+			Listener: listener("http", map[string]interface{}{"http": timeout}),
+		},
+		{
+			Name:     "http2",
+			Listener: listener("http2", map[string]interface{}{"http2": timeout}),
+		},
+		{
+			Name:     "tcp",
+			Listener: listener("tcp", map[string]interface{}{"tcp": timeout}),
+		},
+		{
+			Name:        "grpc timeout on http listener",
+			Listener:    listener("http", map[string]interface{}{"grpc": timeout}),
+			ExpectError: true,
+		},
+		{
+			Name:        "http timeout on http2 listener",
+			Listener:    listener("http2", map[string]interface{}{"http": timeout}),
+			ExpectError: true,
+		},
+		{
+			Name:        "tcp timeout on grpc listener",
+			Listener:    listener("grpc", map[string]interface{}{"tcp": timeout}),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateAppmeshListenerTimeoutProtocol(testCase.Listener)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateAppmeshClientPolicyTrust(t *testing.T) {
+	clientPolicy := func(trust map[string]interface{}) []interface{} {
+		mTrust := map[string]interface{}{
+			"acm":  []interface{}{},
+			"file": []interface{}{},
+			"sds":  []interface{}{},
+		}
+		for k, v := range trust {
+			mTrust[k] = v
+		}
+
+		return []interface{}{map[string]interface{}{
+			"tls": []interface{}{map[string]interface{}{
+				"enforce": true,
+				"ports":   schema.NewSet(schema.HashInt, []interface{}{8443}),
+				"validation": []interface{}{map[string]interface{}{
+					"subject_alternative_names": []interface{}{},
+					"trust":                     []interface{}{mTrust},
+				}},
+			}},
+		}}
+	}
+	acm := []interface{}{map[string]interface{}{"certificate_authority_arns": schema.NewSet(schema.HashString, []interface{}{"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/test"})}}
+	file := []interface{}{map[string]interface{}{"certificate_chain": "/cert_chain.pem"}}
+	sds := []interface{}{map[string]interface{}{"secret_name": "secret"}}
+
+	testCases := []struct {
+		Name         string
+		ClientPolicy []interface{}
+		ExpectError  bool
+	}{
+		{
+			Name: "no client policy",
+		},
+		{
+			Name:         "no tls",
+			ClientPolicy: []interface{}{map[string]interface{}{"tls": []interface{}{}}},
+		},
+		{
+			Name:         "acm",
+			ClientPolicy: clientPolicy(map[string]interface{}{"acm": acm}),
+		},
+		{
+			Name:         "file",
+			ClientPolicy: clientPolicy(map[string]interface{}{"file": file}),
+		},
+		{
+			Name:         "sds",
+			ClientPolicy: clientPolicy(map[string]interface{}{"sds": sds}),
+		},
+		{
+			Name:         "no trust",
+			ClientPolicy: clientPolicy(nil),
+			ExpectError:  true,
+		},
+		{
+			Name:         "acm and file",
+			ClientPolicy: clientPolicy(map[string]interface{}{"acm": acm, "file": file}),
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateAppmeshClientPolicyTrust(testCase.ClientPolicy)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func init() {
 	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
@@ -940,6 +1099,56 @@ func testAccAwsAppmeshVirtualNode_listenerTimeout(t *testing.T) {
 	})
 }
 
+func testAccAwsAppmeshVirtualNode_listenerTimeoutProtocols(t *testing.T) {
+	var vn appmesh.VirtualNodeData
+	resourceName := "aws_appmesh_virtual_node.test"
+	meshName := acctest.RandomWithPrefix("tf-acc-test")
+	vnName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appmesh.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppmeshVirtualNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshVirtualNodeConfig_listenerTimeoutProtocol(meshName, vnName, "grpc", "grpc", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualNodeExists(resourceName, &vn),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.grpc.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.grpc.0.idle.0.value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.grpc.0.per_request.0.value", "5"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http2.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.tcp.#", "0"),
+				),
+			},
+			{
+				Config: testAccAppmeshVirtualNodeConfig_listenerTimeoutProtocol(meshName, vnName, "http2", "http2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualNodeExists(resourceName, &vn),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.grpc.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http2.0.idle.0.value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.http2.0.per_request.0.value", "5"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.timeout.0.tcp.#", "0"),
+				),
+			},
+			{
+				Config:      testAccAppmeshVirtualNodeConfig_listenerTimeoutProtocol(meshName, vnName, "http", "grpc", true),
+				ExpectError: regexp.MustCompile(`timeout.0.grpc cannot be configured for a listener with protocol "http"`),
+			},
+			{
+				Config:      testAccAppmeshVirtualNodeConfig_listenerTimeoutProtocol(meshName, vnName, "grpc", "tcp", false),
+				ExpectError: regexp.MustCompile(`timeout.0.tcp cannot be configured for a listener with protocol "grpc"`),
+			},
+		},
+	})
+}
+
 func testAccAwsAppmeshVirtualNode_listenerTls(t *testing.T) {
 	var vn appmesh.VirtualNodeData
 	var ca acmpca.CertificateAuthority
@@ -1985,6 +2194,50 @@ resource "aws_appmesh_virtual_node" "test" {
 `, vnName))
 }
 
+func testAccAppmeshVirtualNodeConfig_listenerTimeoutProtocol(meshName, vnName, protocol, timeoutProtocol string, perRequest bool) string {
+	perRequestTimeout := ""
+	if perRequest {
+		perRequestTimeout = `
+          per_request {
+            unit  = "s"
+            value = 5
+          }
+`
+	}
+
+	return composeConfig(testAccAppmeshVirtualNodeConfig_mesh(meshName), fmt.Sprintf(`
+resource "aws_appmesh_virtual_node" "test" {
+  name      = %[1]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = %[2]q
+      }
+
+      timeout {
+        %[3]s {
+          idle {
+            unit  = "s"
+            value = 10
+          }
+%[4]s
+        }
+      }
+    }
+
+    service_discovery {
+      dns {
+        hostname = "serviceb.simpleapp.local"
+      }
+    }
+  }
+}
+`, vnName, protocol, timeoutProtocol, perRequestTimeout))
+}
+
 func testAccAppmeshVirtualNodeConfig_listenerTlsFile(meshName, vnName string) string {
 	return composeConfig(testAccAppmeshVirtualNodeConfig_mesh(meshName), fmt.Sprintf(`
 resource "aws_appmesh_virtual_node" "test" {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -58,10 +59,11 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"provider": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MinItems: 0,
-							MaxItems: 1,
+							Type:             schema.TypeList,
+							Optional:         true,
+							MinItems:         0,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressAppmeshVirtualServiceEmptyProviderDiff,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"virtual_node": {
@@ -72,10 +74,18 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 										ConflictsWith: []string{"spec.0.provider.0.virtual_router"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"virtual_node_arn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
 												"virtual_node_name": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringLenBetween(1, 255),
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 255),
+														validateAppmeshResourceName,
+													),
 												},
 											},
 										},
@@ -89,10 +99,18 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 										ConflictsWith: []string{"spec.0.provider.0.virtual_node"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"virtual_router_arn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
 												"virtual_router_name": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringLenBetween(1, 255),
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 255),
+														validateAppmeshResourceName,
+													),
 												},
 											},
 										},
@@ -114,6 +132,11 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 				Computed: true,
 			},
 
+			"is_shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"last_updated_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,6 +197,7 @@ func resourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interface
 
 	var resp *appmesh.DescribeVirtualServiceOutput
 
+	// A virtual service in a mesh shared via RAM may not be visible immediately after creation.
 	err := resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
 		var err error
 
@@ -226,7 +250,9 @@ func resourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interface
 	d.Set("created_date", resp.VirtualService.Metadata.CreatedAt.Format(time.RFC3339))
 	d.Set("last_updated_date", resp.VirtualService.Metadata.LastUpdatedAt.Format(time.RFC3339))
 	d.Set("resource_owner", resp.VirtualService.Metadata.ResourceOwner)
-	err = d.Set("spec", flattenAppmeshVirtualServiceSpec(resp.VirtualService.Spec))
+	d.Set("is_shared", aws.StringValue(resp.VirtualService.Metadata.MeshOwner) != aws.StringValue(resp.VirtualService.Metadata.ResourceOwner))
+	meshARN := appmeshMeshARN(meta, aws.StringValue(resp.VirtualService.Metadata.MeshOwner), aws.StringValue(resp.VirtualService.MeshName))
+	err = d.Set("spec", flattenAppmeshVirtualServiceSpec(resp.VirtualService.Spec, meshARN))
 	if err != nil {
 		return fmt.Errorf("error setting spec: %s", err)
 	}
@@ -261,6 +287,10 @@ func resourceAwsAppmeshVirtualServiceUpdate(d *schema.ResourceData, meta interfa
 			VirtualServiceName: aws.String(d.Get("name").(string)),
 			Spec:               expandAppmeshVirtualServiceSpec(v.([]interface{})),
 		}
+		// Send an empty provider to clear the backend when the provider block is removed.
+		if req.Spec.Provider == nil {
+			req.Spec.Provider = &appmesh.VirtualServiceProvider{}
+		}
 		if v, ok := d.GetOk("mesh_owner"); ok {
 			req.MeshOwner = aws.String(v.(string))
 		}
@@ -304,8 +334,14 @@ func resourceAwsAppmeshVirtualServiceDelete(d *schema.ResourceData, meta interfa
 
 func resourceAwsAppmeshVirtualServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'mesh-name/virtual-service-name'", d.Id())
+	if len(parts) != 2 && len(parts) != 3 {
+		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'mesh-name/virtual-service-name' or 'mesh-owner/mesh-name/virtual-service-name'", d.Id())
+	}
+
+	var meshOwner string
+	if len(parts) == 3 {
+		meshOwner = parts[0]
+		parts = parts[1:]
 	}
 
 	mesh := parts[0]
@@ -314,10 +350,15 @@ func resourceAwsAppmeshVirtualServiceImport(d *schema.ResourceData, meta interfa
 
 	conn := meta.(*AWSClient).appmeshconn
 
-	resp, err := conn.DescribeVirtualService(&appmesh.DescribeVirtualServiceInput{
+	input := &appmesh.DescribeVirtualServiceInput{
 		MeshName:           aws.String(mesh),
 		VirtualServiceName: aws.String(name),
-	})
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	resp, err := conn.DescribeVirtualService(input)
 	if err != nil {
 		return nil, err
 	}
@@ -325,6 +366,49 @@ func resourceAwsAppmeshVirtualServiceImport(d *schema.ResourceData, meta interfa
 	d.SetId(aws.StringValue(resp.VirtualService.Metadata.Uid))
 	d.Set("name", resp.VirtualService.VirtualServiceName)
 	d.Set("mesh_name", resp.VirtualService.MeshName)
+	d.Set("mesh_owner", resp.VirtualService.Metadata.MeshOwner)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// suppressAppmeshVirtualServiceEmptyProviderDiff suppresses differences between an absent provider
+// block and a provider block without a virtual node or virtual router, as the API returns either.
+func suppressAppmeshVirtualServiceEmptyProviderDiff(k, old, new string, d *schema.ResourceData) bool {
+	if k != "spec.0.provider.#" {
+		return false
+	}
+
+	o, n := d.GetChange("spec.0.provider")
+
+	return appmeshVirtualServiceProviderEmpty(o.([]interface{})) && appmeshVirtualServiceProviderEmpty(n.([]interface{}))
+}
+
+// appmeshVirtualServiceProviderEmpty returns whether the provider list has no virtual node or virtual router.
+func appmeshVirtualServiceProviderEmpty(l []interface{}) bool {
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, k := range []string{"virtual_node", "virtual_router"} {
+			if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// appmeshMeshARN returns the ARN of the specified mesh, owned by the specified account.
+func appmeshMeshARN(meta interface{}, meshOwner, meshName string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "appmesh",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meshOwner,
+		Resource:  fmt.Sprintf("mesh/%s", meshName),
+	}.String()
+}
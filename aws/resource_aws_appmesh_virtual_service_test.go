@@ -3,12 +3,15 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -22,60 +25,97 @@ func init() {
 func testSweepAppmeshVirtualServices(region string) error {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*AWSClient).appmeshconn
+	input := &appmesh.ListMeshesInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]*testSweepResource, 0)
 
-	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err = conn.ListMeshesPages(input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, mesh := range page.Meshes {
-			listVirtualServicesInput := &appmesh.ListVirtualServicesInput{
+			input := &appmesh.ListVirtualServicesInput{
 				MeshName: mesh.MeshName,
 			}
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err := conn.ListVirtualServicesPages(listVirtualServicesInput, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
+			err := conn.ListVirtualServicesPages(input, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
 				for _, virtualService := range page.VirtualServices {
-					input := &appmesh.DeleteVirtualServiceInput{
-						MeshName:           mesh.MeshName,
-						VirtualServiceName: virtualService.VirtualServiceName,
-					}
 					virtualServiceName := aws.StringValue(virtualService.VirtualServiceName)
+					r := resourceAwsAppmeshVirtualService()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s/%s", meshName, virtualServiceName))
+					d.Set("mesh_name", meshName)
+					d.Set("name", virtualServiceName)
 
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Service: %s", meshName, virtualServiceName)
-					_, err := conn.DeleteVirtualService(input)
-
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Service (%s): %s", meshName, virtualServiceName, err)
-					}
+					sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
+			if testSweepSkipSweepError(err) {
+				continue
+			}
+
 			if err != nil {
-				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Services: %s", meshName, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Services (%s) in Mesh (%s): %w", region, meshName, err))
 			}
 		}
 
 		return !lastPage
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Print(fmt.Errorf("[WARN] Skipping App Mesh Virtual Services sweep for %s: %w", region, err))
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Virtual Service sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Services: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Meshes (%s): %w", region, err))
 	}
 
-	return nil
+	err = testSweepResourceOrchestrator(sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Services (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAwsAppmeshVirtualService_disappears(t *testing.T) {
+	var vs appmesh.VirtualServiceData
+	resourceName := "aws_appmesh_virtual_service.test"
+	meshName := acctest.RandomWithPrefix("tf-acc-test")
+	vnName1 := acctest.RandomWithPrefix("tf-acc-test")
+	vnName2 := acctest.RandomWithPrefix("tf-acc-test")
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appmesh.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppmeshVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshVirtualServiceConfig_virtualNode(meshName, vnName1, vnName2, vsName, "aws_appmesh_virtual_node.foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppmeshVirtualService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
 }
 
 func testAccAwsAppmeshVirtualService_virtualNode(t *testing.T) {
@@ -103,8 +143,11 @@ func testAccAwsAppmeshVirtualService_virtualNode(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.0.virtual_node_name", vnName1),
-					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
-					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "spec.0.provider.0.virtual_node.0.virtual_node_arn", "appmesh", fmt.Sprintf("mesh/%s/virtualNode/%s", meshName, vnName1)),
+					resource.TestCheckResourceAttrPair(resourceName, "spec.0.provider.0.virtual_node.0.virtual_node_arn", "aws_appmesh_virtual_node.foo", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "created_date", regexp.MustCompile(rfc3339RegexPattern)),
+					resource.TestCheckResourceAttr(resourceName, "is_shared", "false"),
+					resource.TestMatchResourceAttr(resourceName, "last_updated_date", regexp.MustCompile(rfc3339RegexPattern)),
 					testAccCheckResourceAttrAccountID(resourceName, "resource_owner"),
 					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appmesh", fmt.Sprintf("mesh/%s/virtualService/%s", meshName, vsName)),
 				),
@@ -157,8 +200,10 @@ func testAccAwsAppmeshVirtualService_virtualRouter(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.0.virtual_router_name", vrName1),
-					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
-					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "spec.0.provider.0.virtual_router.0.virtual_router_arn", "appmesh", fmt.Sprintf("mesh/%s/virtualRouter/%s", meshName, vrName1)),
+					resource.TestCheckResourceAttrPair(resourceName, "spec.0.provider.0.virtual_router.0.virtual_router_arn", "aws_appmesh_virtual_router.foo", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "created_date", regexp.MustCompile(rfc3339RegexPattern)),
+					resource.TestMatchResourceAttr(resourceName, "last_updated_date", regexp.MustCompile(rfc3339RegexPattern)),
 					testAccCheckResourceAttrAccountID(resourceName, "resource_owner"),
 					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appmesh", fmt.Sprintf("mesh/%s/virtualService/%s", meshName, vsName))),
 			},
@@ -179,6 +224,178 @@ func testAccAwsAppmeshVirtualService_virtualRouter(t *testing.T) {
 	})
 }
 
+func testAccAwsAppmeshVirtualService_emptyProvider(t *testing.T) {
+	var vs appmesh.VirtualServiceData
+	resourceName := "aws_appmesh_virtual_service.test"
+	meshName := acctest.RandomWithPrefix("tf-acc-test")
+	vnName := acctest.RandomWithPrefix("tf-acc-test")
+	vrName := acctest.RandomWithPrefix("tf-acc-test")
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appmesh.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppmeshVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, `
+    provider {}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "0"),
+				),
+			},
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, `
+    provider {}
+`),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAwsAppmeshVirtualService_providerSwitch(t *testing.T) {
+	var vs appmesh.VirtualServiceData
+	resourceName := "aws_appmesh_virtual_service.test"
+	meshName := acctest.RandomWithPrefix("tf-acc-test")
+	vnName := acctest.RandomWithPrefix("tf-acc-test")
+	vrName := acctest.RandomWithPrefix("tf-acc-test")
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appmesh.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppmeshVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, `
+    provider {
+      virtual_node {
+        virtual_node_name = aws_appmesh_virtual_node.test.name
+      }
+    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.0.virtual_node_name", vnName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.#", "0"),
+				),
+			},
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, `
+    provider {
+      virtual_router {
+        virtual_router_name = aws_appmesh_virtual_router.test.name
+      }
+    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.0.virtual_router_name", vrName),
+				),
+			},
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "0"),
+				),
+			},
+			{
+				Config: testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, `
+    provider {
+      virtual_node {
+        virtual_node_name = aws_appmesh_virtual_node.test.name
+      }
+    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_node.0.virtual_node_name", vnName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.provider.0.virtual_router.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsAppmeshVirtualService_sharedMesh(t *testing.T) {
+	var providers []*schema.Provider
+	var vs appmesh.VirtualServiceData
+	resourceName := "aws_appmesh_virtual_service.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(appmesh.EndpointsID, t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, appmesh.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAppmeshVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshVirtualServiceConfig_sharedMesh(rName, vsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttr(resourceName, "name", vsName),
+					resource.TestCheckResourceAttr(resourceName, "mesh_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "mesh_owner", "data.aws_caller_identity.owner", "account_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "resource_owner"),
+					resource.TestCheckResourceAttr(resourceName, "is_shared", "true"),
+				),
+			},
+			{
+				// Subsequent reads must find the virtual service through the mesh owner without retrying.
+				Config: testAccAppmeshVirtualServiceConfig_sharedMesh(rName, vsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshVirtualServiceExists(resourceName, &vs),
+					resource.TestCheckResourceAttrPair(resourceName, "mesh_owner", "data.aws_caller_identity.owner", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAwsAppmeshVirtualServiceMeshOwnerImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsAppmeshVirtualServiceMeshOwnerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["mesh_owner"], rs.Primary.Attributes["mesh_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccAwsAppmeshVirtualService_tags(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
@@ -243,10 +460,15 @@ func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := conn.DescribeVirtualService(&appmesh.DescribeVirtualServiceInput{
+		input := &appmesh.DescribeVirtualServiceInput{
 			MeshName:           aws.String(rs.Primary.Attributes["mesh_name"]),
 			VirtualServiceName: aws.String(rs.Primary.Attributes["name"]),
-		})
+		}
+		if v := rs.Primary.Attributes["mesh_owner"]; v != "" {
+			input.MeshOwner = aws.String(v)
+		}
+
+		_, err := conn.DescribeVirtualService(input)
 		if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
 			continue
 		}
@@ -271,10 +493,15 @@ func testAccCheckAppmeshVirtualServiceExists(name string, v *appmesh.VirtualServ
 			return fmt.Errorf("No ID is set")
 		}
 
-		resp, err := conn.DescribeVirtualService(&appmesh.DescribeVirtualServiceInput{
+		input := &appmesh.DescribeVirtualServiceInput{
 			MeshName:           aws.String(rs.Primary.Attributes["mesh_name"]),
 			VirtualServiceName: aws.String(rs.Primary.Attributes["name"]),
-		})
+		}
+		if v := rs.Primary.Attributes["mesh_owner"]; v != "" {
+			input.MeshOwner = aws.String(v)
+		}
+
+		resp, err := conn.DescribeVirtualService(input)
 		if err != nil {
 			return err
 		}
@@ -369,6 +596,97 @@ resource "aws_appmesh_virtual_service" "test" {
 `, meshName, vrName1, vrName2, vsName, rName)
 }
 
+func testAccAppmeshVirtualServiceConfig_provider(meshName, vnName, vrName, vsName, provider string) string {
+	return fmt.Sprintf(`
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_virtual_node" "test" {
+  name      = %[2]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {}
+}
+
+resource "aws_appmesh_virtual_router" "test" {
+  name      = %[3]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name      = %[4]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+%[5]s
+  }
+}
+`, meshName, vnName, vrName, vsName, provider)
+}
+
+func testAccAppmeshVirtualServiceConfig_sharedMesh(rName, vsName string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "owner" {
+  provider = "awsalternate"
+}
+
+resource "aws_appmesh_mesh" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  provider = "awsalternate"
+
+  resource_arn       = aws_appmesh_mesh.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.current.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_principal_association.test.resource_share_arn
+
+  depends_on = [aws_ram_resource_association.test]
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name       = %[2]q
+  mesh_name  = aws_appmesh_mesh.test.name
+  mesh_owner = data.aws_caller_identity.owner.account_id
+
+  spec {}
+
+  depends_on = [aws_ram_resource_share_accepter.test]
+}
+`, rName, vsName))
+}
+
 func testAccAppmeshVirtualServiceConfig_tags(meshName, vnName1, vnName2, vsName, rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
 	return fmt.Sprintf(`
 resource "aws_appmesh_mesh" "test" {
@@ -1,15 +1,20 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
@@ -19,10 +24,10 @@ import (
 
 func resourceAwsDbEventSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbEventSubscriptionCreate,
-		Read:   resourceAwsDbEventSubscriptionRead,
-		Update: resourceAwsDbEventSubscriptionUpdate,
-		Delete: resourceAwsDbEventSubscriptionDelete,
+		CreateContext: resourceAwsDbEventSubscriptionCreate,
+		Read:          resourceAwsDbEventSubscriptionRead,
+		UpdateContext: resourceAwsDbEventSubscriptionUpdate,
+		Delete:        resourceAwsDbEventSubscriptionDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -82,17 +87,157 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 			"source_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(rds.SourceType_Values(), false),
+				ValidateFunc: validateDbEventSubscriptionSourceType,
 			},
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
+			"validate_source_ids": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsDbEventSubscriptionCustomizeDiff,
+			SetTagsDiff,
+		),
+	}
+}
+
+func resourceAwsDbEventSubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("event_categories", "source_type") || !diff.NewValueKnown("event_categories") || !diff.NewValueKnown("source_type") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("event_categories")
+
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).rdsconn
+	sourceType := diff.Get("source_type").(string)
+
+	eventCategories, err := finder.EventCategoriesBySourceType(conn, sourceType)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS event categories (%s): %w", sourceType, err)
+	}
+
+	return validateDbEventSubscriptionEventCategories(aws.StringValueSlice(expandStringSet(v.(*schema.Set))), eventCategories)
+}
+
+// validateDbEventSubscriptionEventCategories returns an error for any configured event category
+// that only matches an available event category when compared case-insensitively,
+// as the RDS API treats event categories case-sensitively.
+func validateDbEventSubscriptionEventCategories(configured, available []string) error {
+	for _, category := range configured {
+		var suggestion string
+
+		for _, availableCategory := range available {
+			if category == availableCategory {
+				suggestion = ""
+				break
+			}
+
+			if strings.EqualFold(category, availableCategory) {
+				suggestion = availableCategory
+			}
+		}
+
+		if suggestion != "" {
+			return fmt.Errorf("event category %q does not match the case of an available RDS event category, use %q instead", category, suggestion)
+		}
+	}
+
+	return nil
+}
+
+// validateDbEventSubscriptionSourceIDsExist returns an error listing any source IDs that do not
+// refer to an existing RDS resource of the specified source type.
+func validateDbEventSubscriptionSourceIDsExist(sourceType string, ids []string, exists func(string, string) error) error {
+	var missing []string
+
+	for _, id := range ids {
+		err := exists(sourceType, id)
+
+		if tfresource.NotFound(err) {
+			missing = append(missing, id)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading RDS %s (%s): %w", sourceType, id, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("source_ids not found for source_type %s: %s", sourceType, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// dbEventSubscriptionSourceExists returns a NotFoundError if no RDS resource of the specified
+// source type has the specified identifier.
+func dbEventSubscriptionSourceExists(conn *rds.RDS, sourceType, id string) error {
+	var err error
+	var notFoundCode string
+
+	switch sourceType {
+	case rds.SourceTypeDbCluster:
+		_, err = conn.DescribeDBClusters(&rds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBClusterNotFoundFault
+	case rds.SourceTypeDbClusterSnapshot:
+		_, err = conn.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{DBClusterSnapshotIdentifier: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBClusterSnapshotNotFoundFault
+	case rds.SourceTypeDbInstance:
+		_, err = conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBInstanceNotFoundFault
+	case rds.SourceTypeDbParameterGroup:
+		_, err = conn.DescribeDBParameterGroups(&rds.DescribeDBParameterGroupsInput{DBParameterGroupName: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBParameterGroupNotFoundFault
+	case "db-proxy":
+		_, err = conn.DescribeDBProxies(&rds.DescribeDBProxiesInput{DBProxyName: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBProxyNotFoundFault
+	case rds.SourceTypeDbSecurityGroup:
+		_, err = conn.DescribeDBSecurityGroups(&rds.DescribeDBSecurityGroupsInput{DBSecurityGroupName: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBSecurityGroupNotFoundFault
+	case rds.SourceTypeDbSnapshot:
+		_, err = conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{DBSnapshotIdentifier: aws.String(id)})
+		notFoundCode = rds.ErrCodeDBSnapshotNotFoundFault
+	default:
+		return fmt.Errorf("unsupported source_type: %s", sourceType)
+	}
+
+	if tfawserr.ErrCodeEquals(err, notFoundCode) {
+		return &resource.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	return err
+}
+
+// dbEventSubscriptionSourceIDsWithoutSourceTypeDiags warns when source identifiers are configured
+// without a source type, as RDS documents that the two must be specified together.
+func dbEventSubscriptionSourceIDsWithoutSourceTypeDiags(name string, sourceIDs []string, sourceType string) diag.Diagnostics {
+	if len(sourceIDs) == 0 || sourceType != "" {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("RDS Event Subscription (%s) has source_ids set without source_type", name),
+			Detail:        "Set source_type to the type of the RDS resources listed in source_ids.",
+			AttributePath: cty.GetAttrPath("source_type"),
+		},
 	}
 }
 
-func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsDbEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).rdsconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -120,20 +265,36 @@ func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface
 		input.Tags = tags.IgnoreAws().RdsTags()
 	}
 
+	if d.Get("validate_source_ids").(bool) && len(input.SourceIds) > 0 {
+		err := validateDbEventSubscriptionSourceIDsExist(aws.StringValue(input.SourceType), aws.StringValueSlice(input.SourceIds), func(sourceType, id string) error {
+			return dbEventSubscriptionSourceExists(conn, sourceType, id)
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating RDS Event Subscription (%s): %w", name, err))
+		}
+	}
+
 	log.Printf("[DEBUG] Creating RDS Event Subscription: %s", input)
 	output, err := conn.CreateEventSubscription(input)
 
 	if err != nil {
-		return fmt.Errorf("error creating RDS Event Subscription (%s): %w", name, err)
+		return diag.FromErr(fmt.Errorf("error creating RDS Event Subscription (%s): %w", name, err))
 	}
 
 	d.SetId(aws.StringValue(output.EventSubscription.CustSubscriptionId))
 
 	if _, err = waiter.EventSubscriptionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for RDS Event Subscription (%s) create: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) create: %w", d.Id(), err))
+	}
+
+	diags := dbEventSubscriptionSourceIDsWithoutSourceTypeDiags(d.Id(), aws.StringValueSlice(input.SourceIds), aws.StringValue(input.SourceType))
+
+	if err := resourceAwsDbEventSubscriptionRead(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return resourceAwsDbEventSubscriptionRead(d, meta)
+	return diags
 }
 
 func resourceAwsDbEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
@@ -184,10 +345,10 @@ func resourceAwsDbEventSubscriptionRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsDbEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).rdsconn
 
-	if d.HasChangesExcept("tags", "tags_all", "source_ids") {
+	if d.HasChangesExcept("tags", "tags_all", "source_ids", "validate_source_ids") {
 		input := &rds.ModifyEventSubscriptionInput{
 			SubscriptionName: aws.String(d.Id()),
 		}
@@ -212,11 +373,11 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 		_, err := conn.ModifyEventSubscription(input)
 
 		if err != nil {
-			return fmt.Errorf("error updating RDS Event Subscription (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS Event Subscription (%s): %w", d.Id(), err))
 		}
 
 		if _, err = waiter.EventSubscriptionUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("error waiting for RDS Event Subscription (%s) update: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) update: %w", d.Id(), err))
 		}
 	}
 
@@ -224,7 +385,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.RdsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS Event Subscription (%s) tags: %w", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS Event Subscription (%s) tags: %w", d.Get("arn").(string), err))
 		}
 	}
 
@@ -243,7 +404,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 			})
 
 			if err != nil {
-				return fmt.Errorf("error removing RDS Event Subscription (%s) source ID (%s): %w", d.Id(), del, err)
+				return diag.FromErr(fmt.Errorf("error removing RDS Event Subscription (%s) source ID (%s): %w", d.Id(), del, err))
 			}
 		}
 
@@ -255,9 +416,17 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 			})
 
 			if err != nil {
-				return fmt.Errorf("error adding RDS Event Subscription (%s) source ID (%s): %w", d.Id(), add, err)
+				return diag.FromErr(fmt.Errorf("error adding RDS Event Subscription (%s) source ID (%s): %w", d.Id(), add, err))
 			}
 		}
+
+		if _, err := waiter.EventSubscriptionUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Event Subscription (%s) update: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChanges("source_ids", "source_type") {
+		return dbEventSubscriptionSourceIDsWithoutSourceTypeDiags(d.Id(), aws.StringValueSlice(expandStringSet(d.Get("source_ids").(*schema.Set))), d.Get("source_type").(string))
 	}
 
 	return nil
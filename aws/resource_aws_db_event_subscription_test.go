@@ -3,10 +3,14 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -65,6 +69,156 @@ func testSweepDbEventSubscriptions(region string) error {
 	return nil
 }
 
+func TestValidateDbEventSubscriptionEventCategories(t *testing.T) {
+	available := []string{"availability", "backup", "failover", "failure"}
+
+	testCases := []struct {
+		Name          string
+		Configured    []string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:       "empty",
+			Configured: []string{},
+		},
+		{
+			Name:       "matching case",
+			Configured: []string{"failover", "backup"},
+		},
+		{
+			Name:       "unknown category",
+			Configured: []string{"unknown"},
+		},
+		{
+			Name:          "mismatched case",
+			Configured:    []string{"backup", "Failover"},
+			ExpectedError: regexp.MustCompile(`event category "Failover" does not match the case of an available RDS event category, use "failover" instead`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateDbEventSubscriptionEventCategories(testCase.Configured, available)
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDbEventSubscriptionSourceIDsExist(t *testing.T) {
+	existing := map[string]bool{
+		"db-instance/exists": true,
+	}
+
+	exists := func(sourceType, id string) error {
+		if id == "error" {
+			return awserr.New("AccessDenied", "test", nil)
+		}
+
+		if !existing[sourceType+"/"+id] {
+			return &resource.NotFoundError{
+				LastError: awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "test", nil),
+			}
+		}
+
+		return nil
+	}
+
+	testCases := []struct {
+		Name        string
+		IDs         []string
+		ExpectError string
+	}{
+		{
+			Name: "all exist",
+			IDs:  []string{"exists"},
+		},
+		{
+			Name:        "not found",
+			IDs:         []string{"exists", "missing1", "missing2"},
+			ExpectError: "source_ids not found for source_type db-instance: missing1, missing2",
+		},
+		{
+			Name:        "describe error",
+			IDs:         []string{"error"},
+			ExpectError: "error reading RDS db-instance (error): AccessDenied",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateDbEventSubscriptionSourceIDsExist(rds.SourceTypeDbInstance, testCase.IDs, exists)
+
+			if testCase.ExpectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got: %s", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestDbEventSubscriptionSourceIDsWithoutSourceTypeDiags(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		SourceIDs        []string
+		SourceType       string
+		ExpectedWarnings int
+	}{
+		{
+			Name: "no source_ids",
+		},
+		{
+			Name:       "source_ids with source_type",
+			SourceIDs:  []string{"test"},
+			SourceType: rds.SourceTypeDbInstance,
+		},
+		{
+			Name:             "source_ids without source_type",
+			SourceIDs:        []string{"test"},
+			ExpectedWarnings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := dbEventSubscriptionSourceIDsWithoutSourceTypeDiags("test", testCase.SourceIDs, testCase.SourceType)
+
+			if got := len(diags); got != testCase.ExpectedWarnings {
+				t.Fatalf("expected %d warnings, got %d: %v", testCase.ExpectedWarnings, got, diags)
+			}
+
+			for _, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("expected warning, got: %v", d)
+				}
+			}
+		})
+	}
+}
+
 func TestAccAWSDBEventSubscription_basic(t *testing.T) {
 	var v rds.EventSubscription
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -279,6 +433,51 @@ func TestAccAWSDBEventSubscription_Categories(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
+			{
+				Config: testAccAWSDBEventSubscriptionConfigCategories(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBEventSubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event_categories.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "source_type", "db-instance"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBEventSubscription_CategoriesCase(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDBEventSubscriptionConfigCategoriesCase(rName),
+				ExpectError: regexp.MustCompile(`event category "Failover" does not match the case of an available RDS event category, use "failover" instead`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBEventSubscription_SourceIDsWithoutSourceType(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// source_ids without source_type only results in a warning after apply, so planning succeeds.
+				Config:             testAccAWSDBEventSubscriptionConfigSourceIDsWithoutSourceType(rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -487,6 +686,24 @@ resource "aws_db_event_subscription" "test" {
 `, rName)
 }
 
+func testAccAWSDBEventSubscriptionConfigCategoriesCase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_db_event_subscription" "test" {
+  name        = %[1]q
+  sns_topic   = aws_sns_topic.test.arn
+  source_type = "db-instance"
+
+  event_categories = [
+    "Failover",
+  ]
+}
+`, rName)
+}
+
 func testAccAWSDBEventSubscriptionConfigSourceIDsBase(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -529,6 +746,23 @@ resource "aws_db_event_subscription" "test" {
 `, rName))
 }
 
+func testAccAWSDBEventSubscriptionConfigSourceIDsWithoutSourceType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_db_event_subscription" "test" {
+  name      = %[1]q
+  sns_topic = aws_sns_topic.test.arn
+
+  source_ids = [
+    %[1]q,
+  ]
+}
+`, rName)
+}
+
 func testAccAWSDBEventSubscriptionConfigSourceIDsUpdated(rName string) string {
 	return composeConfig(testAccAWSDBEventSubscriptionConfigSourceIDsBase(rName), fmt.Sprintf(`
 resource "aws_db_event_subscription" "test" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -254,6 +255,41 @@ func TestAccAWSDBProxyDefaultTargetGroup_SessionPinningFilters(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBProxyDefaultTargetGroup_ConnectionPoolConfigDrift(t *testing.T) {
+	var dbProxyTargetGroup rds.DBProxyTargetGroup
+	resourceName := "aws_db_proxy_default_target_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfig_ConnectionPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyTargetGroupExists(resourceName, &dbProxyTargetGroup),
+					testAccCheckAWSDBProxyDefaultTargetGroupModifyConnectionPoolConfig(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfig_ConnectionPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyTargetGroupExists(resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "90"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", "SET x=1, y=2"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "75"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_idle_connections_percent", "25"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "connection_pool_config.0.session_pinning_filters.*", "EXCLUDE_VARIABLE_SETS"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBProxyDefaultTargetGroup_disappears(t *testing.T) {
 	var v rds.DBProxy
 	dbProxyResourceName := "aws_db_proxy.test"
@@ -309,6 +345,46 @@ func testAccCheckAWSDBProxyTargetGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckAWSDBProxyDefaultTargetGroupModifyConnectionPoolConfig changes every connection pool
+// setting outside of Terraform so that the following plan must report drift.
+func testAccCheckAWSDBProxyDefaultTargetGroupModifyConnectionPoolConfig(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		_, err := conn.ModifyDBProxyTargetGroup(&rds.ModifyDBProxyTargetGroupInput{
+			DBProxyName:     aws.String(rs.Primary.ID),
+			TargetGroupName: aws.String("default"),
+			ConnectionPoolConfig: &rds.ConnectionPoolConfiguration{
+				ConnectionBorrowTimeout:   aws.Int64(60),
+				InitQuery:                 aws.String("SET x=3"),
+				MaxConnectionsPercent:     aws.Int64(50),
+				MaxIdleConnectionsPercent: aws.Int64(10),
+				SessionPinningFilters:     []*string{},
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error modifying RDS DB Proxy (%s) default target group: %w", rs.Primary.ID, err)
+		}
+
+		stateChangeConf := &resource.StateChangeConf{
+			Pending: []string{rds.DBProxyStatusModifying},
+			Target:  []string{rds.DBProxyStatusAvailable},
+			Refresh: resourceAwsDbProxyDefaultTargetGroupRefreshFunc(conn, rs.Primary.ID),
+			Timeout: 30 * time.Minute,
+		}
+
+		_, err = stateChangeConf.WaitForState()
+
+		return err
+	}
+}
+
 func testAccCheckAWSDBProxyTargetGroupExists(n string, v *rds.DBProxyTargetGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -479,6 +555,22 @@ resource "aws_db_proxy_default_target_group" "test" {
 `
 }
 
+func testAccAWSDBProxyDefaultTargetGroupConfig_ConnectionPoolConfig(rName string) string {
+	return testAccAWSDBProxyDefaultTargetGroupConfigBase(rName) + `
+resource "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = aws_db_proxy.test.name
+
+  connection_pool_config {
+    connection_borrow_timeout    = 90
+    init_query                   = "SET x=1, y=2"
+    max_connections_percent      = 75
+    max_idle_connections_percent = 25
+    session_pinning_filters      = ["EXCLUDE_VARIABLE_SETS"]
+  }
+}
+`
+}
+
 func testAccAWSDBProxyDefaultTargetGroupConfig_ConnectionBorrowTimeout(rName string, connectionBorrowTimeout int) string {
 	return testAccAWSDBProxyDefaultTargetGroupConfigBase(rName) + fmt.Sprintf(`
 resource "aws_db_proxy_default_target_group" "test" {
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsDbProxyEndpoint() *schema.Resource {
@@ -37,6 +38,10 @@ func resourceAwsDbProxyEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"db_proxy_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"db_proxy_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -106,13 +111,21 @@ func resourceAwsDbProxyEndpointCreate(d *schema.ResourceData, meta interface{})
 		params.VpcSecurityGroupIds = expandStringSet(v)
 	}
 
+	id := strings.Join([]string{dbProxyName, dbProxyEndpointName}, "/")
+
+	if err := dbProxyEndpointCreatePrecheck(id, func(id string) (*rds.DBProxyEndpoint, error) {
+		return finder.DBProxyEndpoint(conn, id)
+	}); err != nil {
+		return err
+	}
+
 	_, err := conn.CreateDBProxyEndpoint(&params)
 
 	if err != nil {
 		return fmt.Errorf("error Creating RDS DB Proxy Endpoint (%s/%s): %w", dbProxyName, dbProxyEndpointName, err)
 	}
 
-	d.SetId(strings.Join([]string{dbProxyName, dbProxyEndpointName}, "/"))
+	d.SetId(id)
 
 	if _, err := waiter.DBProxyEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become available: %w", d.Id(), err)
@@ -121,6 +134,25 @@ func resourceAwsDbProxyEndpointCreate(d *schema.ResourceData, meta interface{})
 	return resourceAwsDbProxyEndpointRead(d, meta)
 }
 
+// dbProxyEndpointCreatePrecheck returns an error if a DB proxy endpoint with the specified ID already exists.
+func dbProxyEndpointCreatePrecheck(id string, find func(string) (*rds.DBProxyEndpoint, error)) error {
+	dbProxyEndpoint, err := find(id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): %w", id, err)
+	}
+
+	if dbProxyEndpoint != nil {
+		return fmt.Errorf("RDS DB Proxy Endpoint (%s) already exists, import it into Terraform state with: terraform import aws_db_proxy_endpoint.<name> %s", id, id)
+	}
+
+	return nil
+}
+
 func resourceAwsDbProxyEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -128,13 +160,7 @@ func resourceAwsDbProxyEndpointRead(d *schema.ResourceData, meta interface{}) er
 
 	dbProxyEndpoint, err := finder.DBProxyEndpoint(conn, d.Id())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) {
-		log.Printf("[WARN] RDS DB Proxy Endpoint (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS DB Proxy Endpoint (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -144,14 +170,16 @@ func resourceAwsDbProxyEndpointRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): %w", d.Id(), err)
 	}
 
-	if dbProxyEndpoint == nil {
-		if d.IsNewResource() {
-			return fmt.Errorf("error reading RDS DB Proxy Endpoint (%s): not found after creation", d.Id())
+	// The proxy ARN is keyed on the proxy's resource ID rather than its name and db_proxy_name
+	// forces replacement, so the proxy only needs to be looked up on create and import.
+	if d.Get("db_proxy_arn").(string) == "" {
+		dbProxy, err := finder.DBProxyByName(conn, aws.StringValue(dbProxyEndpoint.DBProxyName))
+
+		if err != nil {
+			return fmt.Errorf("error reading RDS DB Proxy (%s) for RDS DB Proxy Endpoint (%s): %w", aws.StringValue(dbProxyEndpoint.DBProxyName), d.Id(), err)
 		}
 
-		log.Printf("[WARN] RDS DB Proxy Endpoint (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+		d.Set("db_proxy_arn", dbProxy.DBProxyArn)
 	}
 
 	endpointArn := aws.StringValue(dbProxyEndpoint.DBProxyEndpointArn)
@@ -200,7 +228,7 @@ func resourceAwsDbProxyEndpointUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error updating DB Proxy Endpoint: %w", err)
 		}
 
-		if _, err := waiter.DBProxyEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waiter.DBProxyEndpointModified(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for RDS DB Proxy Endpoint (%s) to become modified: %w", d.Id(), err)
 		}
 	}
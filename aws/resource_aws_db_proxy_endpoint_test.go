@@ -3,16 +3,71 @@ package aws
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func TestDbProxyEndpointCreatePrecheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Output      *rds.DBProxyEndpoint
+		Err         error
+		ExpectError string
+	}{
+		{
+			Name: "empty result",
+			Err:  tfresource.NewEmptyResultError(nil),
+		},
+		{
+			Name: "endpoint not found fault",
+			Err:  &resource.NotFoundError{LastError: awserr.New(rds.ErrCodeDBProxyEndpointNotFoundFault, "not found", nil)},
+		},
+		{
+			Name:        "already exists",
+			Output:      &rds.DBProxyEndpoint{DBProxyEndpointName: aws.String("endpoint")},
+			ExpectError: "RDS DB Proxy Endpoint (proxy/endpoint) already exists, import it into Terraform state with: terraform import aws_db_proxy_endpoint.<name> proxy/endpoint",
+		},
+		{
+			Name:        "describe error",
+			Err:         awserr.New("AccessDenied", "denied", nil),
+			ExpectError: "error reading RDS DB Proxy Endpoint (proxy/endpoint): AccessDenied",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := dbProxyEndpointCreatePrecheck("proxy/endpoint", func(id string) (*rds.DBProxyEndpoint, error) {
+				return testCase.Output, testCase.Err
+			})
+
+			if testCase.ExpectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got: %s", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
 func TestAccAWSDBProxyEndpoint_basic(t *testing.T) {
 	var v rds.DBProxyEndpoint
 	resourceName := "aws_db_proxy_endpoint.test"
@@ -30,6 +85,7 @@ func TestAccAWSDBProxyEndpoint_basic(t *testing.T) {
 					testAccCheckAWSDBProxyEndpointExists(resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "db_proxy_endpoint_name", rName),
 					resource.TestCheckResourceAttrPair(resourceName, "db_proxy_name", "aws_db_proxy.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_proxy_arn", "aws_db_proxy.test", "arn"),
 					resource.TestCheckResourceAttr(resourceName, "target_role", "READ_WRITE"),
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`db-proxy-endpoint:.+`)),
 					resource.TestCheckResourceAttr(resourceName, "vpc_subnet_ids.#", "2"),
@@ -106,6 +162,7 @@ func TestAccAWSDBProxyEndpoint_vpcSecurityGroupIds(t *testing.T) {
 				Config: testAccAWSDBProxyEndpointConfigVpcSecurityGroupIds2(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSDBProxyEndpointExists(resourceName, &dbProxy),
+					testAccCheckAWSDBProxyEndpointStatus(&dbProxy, rds.DBProxyEndpointStatusAvailable),
 					resource.TestCheckResourceAttr(resourceName, "vpc_security_group_ids.#", "2"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_security_group_ids.*", "aws_security_group.test", "id"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_security_group_ids.*", "aws_security_group.test2", "id"),
@@ -227,9 +284,9 @@ func testAccCheckAWSDBProxyEndpointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		dbProxyEndpoint, err := finder.DBProxyEndpoint(conn, rs.Primary.ID)
+		_, err := finder.DBProxyEndpoint(conn, rs.Primary.ID)
 
-		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyEndpointNotFoundFault) {
+		if tfresource.NotFound(err) {
 			continue
 		}
 
@@ -237,9 +294,7 @@ func testAccCheckAWSDBProxyEndpointDestroy(s *terraform.State) error {
 			return err
 		}
 
-		if dbProxyEndpoint != nil {
-			return fmt.Errorf("RDS DB Proxy Endpoint (%s) still exists", rs.Primary.ID)
-		}
+		return fmt.Errorf("RDS DB Proxy Endpoint (%s) still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -264,16 +319,22 @@ func testAccCheckAWSDBProxyEndpointExists(n string, v *rds.DBProxyEndpoint) reso
 			return err
 		}
 
-		if dbProxyEndpoint == nil {
-			return fmt.Errorf("RDS DB Proxy Endpoint (%s) still not found", rs.Primary.ID)
-		}
-
 		*v = *dbProxyEndpoint
 
 		return nil
 	}
 }
 
+func testAccCheckAWSDBProxyEndpointStatus(v *rds.DBProxyEndpoint, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := aws.StringValue(v.Status); actual != expected {
+			return fmt.Errorf("RDS DB Proxy Endpoint (%s) status is %s, expected %s", aws.StringValue(v.DBProxyEndpointName), actual, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSDBProxyEndpointConfigBase(rName string) string {
 	return fmt.Sprintf(`
 # Secrets Manager setup
@@ -24,6 +24,7 @@ func init() {
 		Dependencies: []string{
 			"aws_eks_addon",
 			"aws_eks_fargate_profile",
+			"aws_eks_identity_provider_config",
 			"aws_eks_node_group",
 		},
 	})
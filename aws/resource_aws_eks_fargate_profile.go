@@ -67,10 +67,11 @@ func resourceAwsEksFargateProfile() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"labels": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ForceNew:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateEKSFargateProfileSelectorLabels,
 						},
 						"namespace": {
 							Type:         schema.TypeString,
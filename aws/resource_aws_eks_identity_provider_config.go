@@ -28,7 +28,7 @@ func resourceAwsEksIdentityProviderConfig() *schema.Resource {
 		DeleteWithoutTimeout: resourceAwsEksIdentityProviderConfigDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAwsEksIdentityProviderConfigImport,
 		},
 
 		CustomizeDiff: SetTagsDiff,
@@ -51,6 +51,11 @@ func resourceAwsEksIdentityProviderConfig() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"identity_provider_config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"oidc": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -86,7 +91,7 @@ func resourceAwsEksIdentityProviderConfig() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
+							ValidateFunc: validateEksIdentityProviderConfigIssuerUrl,
 						},
 						"required_claims": {
 							Type:     schema.TypeMap,
@@ -157,6 +162,20 @@ func resourceAwsEksIdentityProviderConfigCreate(ctx context.Context, d *schema.R
 
 	_, err := conn.AssociateIdentityProviderConfig(input)
 
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceInUseException) {
+		if configs, listErr := finder.IdentityProviderConfigsByClusterName(ctx, conn, clusterName); listErr == nil {
+			var existingConfigNames []string
+
+			for _, config := range configs {
+				if name := aws.StringValue(config.Name); name != configName {
+					existingConfigNames = append(existingConfigNames, name)
+				}
+			}
+
+			err = tfeks.IdentityProviderConfigInUseError(err, clusterName, existingConfigNames)
+		}
+	}
+
 	if err != nil {
 		return diag.Errorf("error associating EKS Identity Provider Config (%s): %s", id, err)
 	}
@@ -195,8 +214,16 @@ func resourceAwsEksIdentityProviderConfigRead(ctx context.Context, d *schema.Res
 		return diag.Errorf("error reading EKS Identity Provider Config (%s): %s", d.Id(), err)
 	}
 
-	d.Set("arn", oidc.IdentityProviderConfigArn)
+	configARN := aws.StringValue(oidc.IdentityProviderConfigArn)
+	configID, err := tfeks.IdentityProviderConfigIDFromARN(configARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("arn", configARN)
 	d.Set("cluster_name", oidc.ClusterName)
+	d.Set("identity_provider_config_id", configID)
 
 	if err := d.Set("oidc", []interface{}{flattenEksOidcIdentityProviderConfig(oidc)}); err != nil {
 		return diag.Errorf("error setting oidc: %s", err)
@@ -204,6 +231,7 @@ func resourceAwsEksIdentityProviderConfigRead(ctx context.Context, d *schema.Res
 
 	d.Set("status", oidc.Status)
 
+	// Tags are returned by DescribeIdentityProviderConfig, so no separate ListTagsForResource call is made.
 	tags := keyvaluetags.EksKeyValueTags(oidc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -240,13 +268,19 @@ func resourceAwsEksIdentityProviderConfigDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Disassociating EKS Identity Provider Config: %s", d.Id())
-	_, err = conn.DisassociateIdentityProviderConfigWithContext(ctx, &eks.DisassociateIdentityProviderConfigInput{
+	input := &eks.DisassociateIdentityProviderConfigInput{
 		ClusterName: aws.String(clusterName),
 		IdentityProviderConfig: &eks.IdentityProviderConfig{
 			Name: aws.String(configName),
 			Type: aws.String(tfeks.IdentityProviderConfigTypeOidc),
 		},
+	}
+
+	log.Printf("[DEBUG] Disassociating EKS Identity Provider Config: %s", d.Id())
+	err = retryEksIdentityProviderConfigDisassociate(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := conn.DisassociateIdentityProviderConfigWithContext(ctx, input)
+
+		return err
 	})
 
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
@@ -270,6 +304,43 @@ func resourceAwsEksIdentityProviderConfigDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+// retryEksIdentityProviderConfigDisassociate retries the specified disassociation while the cluster
+// reports a conflicting operation in progress (e.g. a version upgrade).
+func retryEksIdentityProviderConfigDisassociate(ctx context.Context, timeout time.Duration, f func() error) error {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		err := f()
+
+		if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceInUseException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = f()
+	}
+
+	return err
+}
+
+func resourceAwsEksIdentityProviderConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterName, configName, err := tfeks.IdentityProviderConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize an imported ARN to the cluster-name:config-name resource ID.
+	d.SetId(tfeks.IdentityProviderConfigCreateResourceID(clusterName, configName))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandEksOidcIdentityProviderConfigRequest(tfMap map[string]interface{}) (string, *eks.OidcIdentityProviderConfigRequest) {
 	if tfMap == nil {
 		return "", nil
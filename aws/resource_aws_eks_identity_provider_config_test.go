@@ -2,12 +2,17 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -92,6 +97,76 @@ func testSweepEksIdentityProviderConfigs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestRetryEksIdentityProviderConfigDisassociate(t *testing.T) {
+	var callCount int32
+
+	testCases := []struct {
+		Name          string
+		F             func() error
+		ExpectError   bool
+		ExpectedCalls int32
+	}{
+		{
+			Name: "no error",
+			F: func() error {
+				atomic.AddInt32(&callCount, 1)
+				return nil
+			},
+			ExpectedCalls: 1,
+		},
+		{
+			Name: "non-retryable error",
+			F: func() error {
+				atomic.AddInt32(&callCount, 1)
+				return awserr.New(eks.ErrCodeInvalidParameterException, "test message", nil)
+			},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+		{
+			Name: "conflict then success",
+			F: func() error {
+				if atomic.AddInt32(&callCount, 1) == 1 {
+					return awserr.New(eks.ErrCodeResourceInUseException, "Cluster has update in progress", nil)
+				}
+
+				return nil
+			},
+			ExpectedCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			callCount = 0
+
+			err := retryEksIdentityProviderConfigDisassociate(context.Background(), 5*time.Second, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := atomic.LoadInt32(&callCount); got != testCase.ExpectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.ExpectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryEksIdentityProviderConfigDisassociate_timeout(t *testing.T) {
+	conflictErr := awserr.New(eks.ErrCodeResourceInUseException, "Cluster has update in progress", nil)
+
+	err := retryEksIdentityProviderConfigDisassociate(context.Background(), 1*time.Second, func() error {
+		return conflictErr
+	})
+
+	if !errors.Is(err, conflictErr) {
+		t.Fatalf("expected conflict error, got: %v", err)
+	}
+}
+
 func TestAccAWSEksIdentityProviderConfig_basic(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -115,6 +190,8 @@ func TestAccAWSEksIdentityProviderConfig_basic(t *testing.T) {
 					testAccCheckAWSEksIdentityProviderConfigExists(ctx, resourceName, &config),
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "eks", regexp.MustCompile(fmt.Sprintf("identityproviderconfig/%[1]s/oidc/%[1]s/.+", rName))),
 					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", eksClusterResourceName, "name"),
+					resource.TestMatchResourceAttr(resourceName, "identity_provider_config_id", regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)),
+					testAccCheckAWSEksIdentityProviderConfigIDMatchesARN(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "oidc.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "oidc.0.client_id", "example.net"),
 					resource.TestCheckResourceAttr(resourceName, "oidc.0.groups_claim", ""),
@@ -132,6 +209,12 @@ func TestAccAWSEksIdentityProviderConfig_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEksIdentityProviderConfigImportStateIdFuncARN(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -244,6 +327,32 @@ func TestAccAWSEksIdentityProviderConfig_Tags(t *testing.T) {
 	})
 }
 
+func testAccCheckAWSEksIdentityProviderConfigIDMatchesARN(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if arn, id := rs.Primary.Attributes["arn"], rs.Primary.Attributes["identity_provider_config_id"]; !strings.HasSuffix(arn, "/"+id) {
+			return fmt.Errorf("identity_provider_config_id (%s) does not match ARN (%s)", id, arn)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEksIdentityProviderConfigImportStateIdFuncARN(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
 func testAccCheckAWSEksIdentityProviderConfigExists(ctx context.Context, resourceName string, config *eks.OidcIdentityProviderConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
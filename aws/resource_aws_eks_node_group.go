@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -280,6 +281,20 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_capacity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -361,12 +376,16 @@ func resourceAwsEksNodeGroupCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(id)
 
-	_, err = waiter.NodegroupCreated(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutCreate))
+	nodeGroup, err := waiter.NodegroupCreated(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return diag.Errorf("error waiting for EKS Node Group (%s) to create: %s", d.Id(), err)
 	}
 
+	if err := resourceAwsEksNodeGroupWaitForCapacity(ctx, d, meta, nodeGroup, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for EKS Node Group (%s) healthy capacity: %s", d.Id(), err)
+	}
+
 	return resourceAwsEksNodeGroupRead(ctx, d, meta)
 }
 
@@ -566,6 +585,18 @@ func resourceAwsEksNodeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChanges("launch_template", "release_version", "scaling_config", "version") {
+		nodeGroup, err := finder.NodegroupByClusterNameAndNodegroupName(conn, clusterName, nodeGroupName)
+
+		if err != nil {
+			return diag.Errorf("error reading EKS Node Group (%s): %s", d.Id(), err)
+		}
+
+		if err := resourceAwsEksNodeGroupWaitForCapacity(ctx, d, meta, nodeGroup, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for EKS Node Group (%s) healthy capacity: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
@@ -576,6 +607,55 @@ func resourceAwsEksNodeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourceAwsEksNodeGroupRead(ctx, d, meta)
 }
 
+// resourceAwsEksNodeGroupWaitForCapacity waits until the configured percentage of the node group's
+// desired nodes are healthy. It does nothing unless wait_for_capacity is configured.
+func resourceAwsEksNodeGroupWaitForCapacity(ctx context.Context, d *schema.ResourceData, meta interface{}, nodeGroup *eks.Nodegroup, timeout time.Duration) error {
+	v, ok := d.GetOk("wait_for_capacity")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	required := eksNodeGroupRequiredHealthyNodes(nodeGroup, tfMap["min_healthy_percentage"].(int))
+
+	if required == 0 {
+		return nil
+	}
+
+	var autoScalingGroupNames []string
+
+	if nodeGroup.Resources != nil {
+		for _, autoScalingGroup := range nodeGroup.Resources.AutoScalingGroups {
+			if autoScalingGroup == nil {
+				continue
+			}
+
+			autoScalingGroupNames = append(autoScalingGroupNames, aws.StringValue(autoScalingGroup.Name))
+		}
+	}
+
+	if len(autoScalingGroupNames) == 0 {
+		return fmt.Errorf("no Auto Scaling groups found")
+	}
+
+	_, err := waiter.NodegroupCapacityHealthy(ctx, meta.(*AWSClient).autoscalingconn, autoScalingGroupNames, required, timeout)
+
+	return err
+}
+
+// eksNodeGroupRequiredHealthyNodes returns the number of healthy nodes needed for the given percentage
+// of the node group's desired size, rounding up.
+func eksNodeGroupRequiredHealthyNodes(nodeGroup *eks.Nodegroup, minHealthyPercentage int) int {
+	if nodeGroup == nil || nodeGroup.ScalingConfig == nil {
+		return 0
+	}
+
+	desiredSize := int(aws.Int64Value(nodeGroup.ScalingConfig.DesiredSize))
+
+	return (desiredSize*minHealthyPercentage + 99) / 100
+}
+
 func resourceAwsEksNodeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).eksconn
 
@@ -725,7 +805,7 @@ func expandEksUpdateTaintsPayload(oldTaintsRaw, newTaintsRaw []interface{}) *eks
 
 		updated := true
 		for _, ot := range oldTaints {
-			if nt == nil {
+			if ot == nil {
 				continue
 			}
 
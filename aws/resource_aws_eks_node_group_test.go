@@ -3,10 +3,12 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/eks"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -93,6 +95,142 @@ func testSweepEksNodeGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestExpandEksUpdateTaintsPayload(t *testing.T) {
+	taint := func(key, value, effect string) map[string]interface{} {
+		return map[string]interface{}{
+			"key":    key,
+			"value":  value,
+			"effect": effect,
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Old      []interface{}
+		New      []interface{}
+		Expected *eks.UpdateTaintsPayload
+	}{
+		{
+			Name:     "no change",
+			Old:      []interface{}{taint("key1", "value1", eks.TaintEffectNoSchedule)},
+			New:      []interface{}{taint("key1", "value1", eks.TaintEffectNoSchedule)},
+			Expected: nil,
+		},
+		{
+			Name: "add taint",
+			Old:  []interface{}{},
+			New:  []interface{}{taint("key1", "value1", eks.TaintEffectNoSchedule)},
+			Expected: &eks.UpdateTaintsPayload{
+				AddOrUpdateTaints: []*eks.Taint{
+					{Key: aws.String("key1"), Value: aws.String("value1"), Effect: aws.String(eks.TaintEffectNoSchedule)},
+				},
+			},
+		},
+		{
+			Name: "change value",
+			Old:  []interface{}{taint("key1", "value1", eks.TaintEffectNoSchedule)},
+			New:  []interface{}{taint("key1", "value2", eks.TaintEffectNoSchedule)},
+			Expected: &eks.UpdateTaintsPayload{
+				AddOrUpdateTaints: []*eks.Taint{
+					{Key: aws.String("key1"), Value: aws.String("value2"), Effect: aws.String(eks.TaintEffectNoSchedule)},
+				},
+			},
+		},
+		{
+			Name: "change effect",
+			Old:  []interface{}{taint("key1", "value1", eks.TaintEffectNoSchedule)},
+			New:  []interface{}{taint("key1", "value1", eks.TaintEffectNoExecute)},
+			Expected: &eks.UpdateTaintsPayload{
+				AddOrUpdateTaints: []*eks.Taint{
+					{Key: aws.String("key1"), Value: aws.String("value1"), Effect: aws.String(eks.TaintEffectNoExecute)},
+				},
+				RemoveTaints: []*eks.Taint{
+					{Key: aws.String("key1"), Value: aws.String("value1"), Effect: aws.String(eks.TaintEffectNoSchedule)},
+				},
+			},
+		},
+		{
+			Name: "remove taint",
+			Old: []interface{}{
+				taint("key1", "value1", eks.TaintEffectNoSchedule),
+				taint("key2", "value2", eks.TaintEffectNoExecute),
+			},
+			New: []interface{}{taint("key2", "value2", eks.TaintEffectNoExecute)},
+			Expected: &eks.UpdateTaintsPayload{
+				RemoveTaints: []*eks.Taint{
+					{Key: aws.String("key1"), Value: aws.String("value1"), Effect: aws.String(eks.TaintEffectNoSchedule)},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandEksUpdateTaintsPayload(testCase.Old, testCase.New)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestEksNodeGroupRequiredHealthyNodes(t *testing.T) {
+	nodeGroup := func(desiredSize int64) *eks.Nodegroup {
+		return &eks.Nodegroup{
+			ScalingConfig: &eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(desiredSize),
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name                 string
+		NodeGroup            *eks.Nodegroup
+		MinHealthyPercentage int
+		Expected             int
+	}{
+		{
+			Name:                 "no scaling config",
+			NodeGroup:            &eks.Nodegroup{},
+			MinHealthyPercentage: 100,
+			Expected:             0,
+		},
+		{
+			Name:                 "zero desired size",
+			NodeGroup:            nodeGroup(0),
+			MinHealthyPercentage: 100,
+			Expected:             0,
+		},
+		{
+			Name:                 "all nodes",
+			NodeGroup:            nodeGroup(3),
+			MinHealthyPercentage: 100,
+			Expected:             3,
+		},
+		{
+			Name:                 "rounds up",
+			NodeGroup:            nodeGroup(3),
+			MinHealthyPercentage: 50,
+			Expected:             2,
+		},
+		{
+			Name:                 "at least one node",
+			NodeGroup:            nodeGroup(10),
+			MinHealthyPercentage: 1,
+			Expected:             1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := eksNodeGroupRequiredHealthyNodes(testCase.NodeGroup, testCase.MinHealthyPercentage); got != testCase.Expected {
+				t.Errorf("expected %d, got %d", testCase.Expected, got)
+			}
+		})
+	}
+}
+
 func TestAccAWSEksNodeGroup_basic(t *testing.T) {
 	var nodeGroup eks.Nodegroup
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -987,6 +1125,44 @@ func TestAccAWSEksNodeGroup_UpdateConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSEksNodeGroup_WaitForCapacity(t *testing.T) {
+	var nodeGroup1 eks.Nodegroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		ErrorCheck:   testAccErrorCheck(t, eks.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksNodeGroupConfigWaitForCapacity(rName, 2, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksNodeGroupExists(resourceName, &nodeGroup1),
+					testAccCheckAWSEksNodeGroupHealthyNodes(&nodeGroup1, 2),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_capacity.0.min_healthy_percentage", "100"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_capacity"},
+			},
+			{
+				Config: testAccAWSEksNodeGroupConfigWaitForCapacity(rName, 3, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksNodeGroupExists(resourceName, &nodeGroup1),
+					testAccCheckAWSEksNodeGroupHealthyNodes(&nodeGroup1, 3),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.0.desired_size", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEksNodeGroup_Version(t *testing.T) {
 	var nodeGroup1, nodeGroup2 eks.Nodegroup
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -1059,6 +1235,48 @@ func testAccCheckAWSEksNodeGroupExists(resourceName string, nodeGroup *eks.Nodeg
 	}
 }
 
+// testAccCheckAWSEksNodeGroupHealthyNodes verifies that the node group's Auto Scaling groups have
+// at least the expected number of InService and Healthy instances once the resource has been applied.
+func testAccCheckAWSEksNodeGroupHealthyNodes(nodeGroup *eks.Nodegroup, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+
+		if nodeGroup.Resources == nil || len(nodeGroup.Resources.AutoScalingGroups) == 0 {
+			return fmt.Errorf("EKS Node Group (%s) has no Auto Scaling groups", aws.StringValue(nodeGroup.NodegroupName))
+		}
+
+		var names []*string
+
+		for _, autoScalingGroup := range nodeGroup.Resources.AutoScalingGroups {
+			names = append(names, autoScalingGroup.Name)
+		}
+
+		output, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: names,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var healthy int
+
+		for _, group := range output.AutoScalingGroups {
+			for _, instance := range group.Instances {
+				if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService && aws.StringValue(instance.HealthStatus) == "Healthy" {
+					healthy++
+				}
+			}
+		}
+
+		if healthy < expected {
+			return fmt.Errorf("EKS Node Group (%s) has %d healthy nodes, expected at least %d", aws.StringValue(nodeGroup.NodegroupName), healthy, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).eksconn
 
@@ -2146,6 +2364,33 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccAWSEksNodeGroupConfigWaitForCapacity(rName string, desiredSize, minHealthyPercentage int) string {
+	return composeConfig(testAccAWSEksNodeGroupConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  scaling_config {
+    desired_size = %[2]d
+    max_size     = 3
+    min_size     = 1
+  }
+
+  wait_for_capacity {
+    min_healthy_percentage = %[3]d
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName, desiredSize, minHealthyPercentage))
+}
+
 func testAccAWSEksNodeGroupConfigVersion(rName, version string) string {
 	return composeConfig(testAccAWSEksNodeGroupConfigBaseVersion(rName, version), fmt.Sprintf(`
 resource "aws_eks_node_group" "test" {
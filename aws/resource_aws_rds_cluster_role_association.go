@@ -1,13 +1,19 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iamfinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
 	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
@@ -18,12 +24,18 @@ func resourceAwsRDSClusterRoleAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsRDSClusterRoleAssociationCreate,
 		Read:   resourceAwsRDSClusterRoleAssociationRead,
+		Update: schema.Noop,
 		Delete: resourceAwsRDSClusterRoleAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DBClusterRoleAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.DBClusterRoleAssociationDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_cluster_identifier": {
 				Type:     schema.TypeString,
@@ -41,6 +53,11 @@ func resourceAwsRDSClusterRoleAssociation() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateArn,
 			},
+			"validate_role_trust": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -50,6 +67,19 @@ func resourceAwsRDSClusterRoleAssociationCreate(d *schema.ResourceData, meta int
 
 	dbClusterID := d.Get("db_cluster_identifier").(string)
 	roleARN := d.Get("role_arn").(string)
+
+	if d.Get("validate_role_trust").(bool) {
+		iamconn := meta.(*AWSClient).iamconn
+
+		err := validateRdsClusterRoleTrust(roleARN, func(name string) (*iam.Role, error) {
+			return iamfinder.RoleByName(iamconn, name)
+		})
+
+		if err != nil {
+			return fmt.Errorf("error validating RDS DB Cluster (%s) IAM Role (%s) trust: %w", dbClusterID, roleARN, err)
+		}
+	}
+
 	input := &rds.AddRoleToDBClusterInput{
 		DBClusterIdentifier: aws.String(dbClusterID),
 		FeatureName:         aws.String(d.Get("feature_name").(string)),
@@ -65,7 +95,7 @@ func resourceAwsRDSClusterRoleAssociationCreate(d *schema.ResourceData, meta int
 
 	d.SetId(tfrds.ClusterRoleAssociationCreateResourceID(dbClusterID, roleARN))
 
-	_, err = waiter.DBClusterRoleAssociationCreated(conn, dbClusterID, roleARN)
+	_, err = waiter.DBClusterRoleAssociationCreated(conn, dbClusterID, roleARN, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to create: %w", dbClusterID, roleARN, err)
@@ -128,7 +158,7 @@ func resourceAwsRDSClusterRoleAssociationDelete(d *schema.ResourceData, meta int
 		return fmt.Errorf("error deleting RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err)
 	}
 
-	_, err = waiter.DBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN)
+	_, err = waiter.DBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to delete: %w", dbClusterID, roleARN, err)
@@ -136,3 +166,65 @@ func resourceAwsRDSClusterRoleAssociationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// validateRdsClusterRoleTrust returns an error if the IAM role's trust policy does not allow
+// the RDS service principal to assume it, as feature operations using the role would fail later.
+func validateRdsClusterRoleTrust(roleARN string, getRole func(string) (*iam.Role, error)) error {
+	parsedARN, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+	role, err := getRole(parts[len(parts)-1])
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role: %w", err)
+	}
+
+	policy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return fmt.Errorf("error decoding assume role policy: %w", err)
+	}
+
+	var doc IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return fmt.Errorf("error parsing assume role policy: %w", err)
+	}
+
+	for _, statement := range doc.Statements {
+		if statement == nil || statement.Effect != "Allow" {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type != "Service" {
+				continue
+			}
+
+			switch v := principal.Identifiers.(type) {
+			case string:
+				if v == tfrds.ServicePrincipal {
+					return nil
+				}
+			case []string:
+				for _, identifier := range v {
+					if identifier == tfrds.ServicePrincipal {
+						return nil
+					}
+				}
+			case []interface{}:
+				for _, identifier := range v {
+					if identifier, ok := identifier.(string); ok && identifier == tfrds.ServicePrincipal {
+						return nil
+					}
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("assume role policy does not trust %s", tfrds.ServicePrincipal)
+}
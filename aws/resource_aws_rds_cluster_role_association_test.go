@@ -2,8 +2,13 @@ package aws
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -44,6 +49,39 @@ func TestAccAWSRDSClusterRoleAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSClusterRoleAssociation_multipleFeatures(t *testing.T) {
+	var dbClusterRole1, dbClusterRole2 rds.DBClusterRole
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName1 := "aws_rds_cluster_role_association.test"
+	resourceName2 := "aws_rds_cluster_role_association.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRDSClusterRoleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRDSClusterRoleAssociationConfigMultipleFeatures(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSClusterRoleAssociationExists(resourceName1, &dbClusterRole1),
+					testAccCheckAWSRDSClusterRoleAssociationExists(resourceName2, &dbClusterRole2),
+					resource.TestCheckResourceAttr(resourceName1, "feature_name", "s3Import"),
+					resource.TestCheckResourceAttrPair(resourceName1, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName2, "feature_name", "s3Export"),
+					resource.TestCheckResourceAttrPair(resourceName2, "role_arn", "aws_iam_role.test2", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName1, "db_cluster_identifier", resourceName2, "db_cluster_identifier"),
+				),
+			},
+			{
+				ResourceName:      resourceName2,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSClusterRoleAssociation_disappears(t *testing.T) {
 	var dbClusterRole rds.DBClusterRole
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -115,6 +153,84 @@ func TestAccAWSRDSClusterRoleAssociation_disappears_role(t *testing.T) {
 	})
 }
 
+func TestValidateRdsClusterRoleTrust(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		GetRoleErr  error
+		ExpectError string
+	}{
+		{
+			Name:   "trusted",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"rds.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			Name:   "trusted among multiple services",
+			Policy: url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","rds.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`),
+		},
+		{
+			Name:   "trusted among multiple principals",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root"],"Service":["ec2.amazonaws.com","rds.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			Name:        "not trusted among multiple principals",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root","Service":["ec2.amazonaws.com","lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			ExpectError: "assume role policy does not trust rds.amazonaws.com",
+		},
+		{
+			Name:        "not trusted",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ExpectError: "assume role policy does not trust rds.amazonaws.com",
+		},
+		{
+			Name:        "denied",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"rds.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ExpectError: "assume role policy does not trust rds.amazonaws.com",
+		},
+		{
+			Name:        "role not found",
+			GetRoleErr:  awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil),
+			ExpectError: "error reading IAM Role",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var gotName string
+
+			err := validateRdsClusterRoleTrust("arn:aws:iam::123456789012:role/path/tf-acc-test", func(name string) (*iam.Role, error) {
+				gotName = name
+
+				if testCase.GetRoleErr != nil {
+					return nil, testCase.GetRoleErr
+				}
+
+				return &iam.Role{AssumeRolePolicyDocument: aws.String(testCase.Policy)}, nil
+			})
+
+			if got, want := gotName, "tf-acc-test"; got != want {
+				t.Errorf("expected role name %s, got %s", want, got)
+			}
+
+			if testCase.ExpectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got: %s", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
 func testAccCheckAWSRDSClusterRoleAssociationExists(resourceName string, v *rds.DBClusterRole) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -173,7 +289,7 @@ func testAccCheckAWSRDSClusterRoleAssociationDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccAWSRDSClusterRoleAssociationConfig(rName string) string {
+func testAccAWSRDSClusterRoleAssociationConfigBase(rName string) string {
 	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
 data "aws_iam_policy_document" "rds_assume_role_policy" {
   statement {
@@ -203,11 +319,39 @@ resource "aws_rds_cluster" "test" {
   preferred_backup_window = "07:00-09:00"
   skip_final_snapshot     = true
 }
+`, rName))
+}
 
+func testAccAWSRDSClusterRoleAssociationConfig(rName string) string {
+	return composeConfig(testAccAWSRDSClusterRoleAssociationConfigBase(rName), `
 resource "aws_rds_cluster_role_association" "test" {
   db_cluster_identifier = aws_rds_cluster.test.id
   feature_name          = "s3Import"
   role_arn              = aws_iam_role.test.arn
 }
+`)
+}
+
+func testAccAWSRDSClusterRoleAssociationConfigMultipleFeatures(rName string) string {
+	return composeConfig(testAccAWSRDSClusterRoleAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  assume_role_policy = data.aws_iam_policy_document.rds_assume_role_policy.json
+  name               = "%[1]s-2"
+}
+
+resource "aws_rds_cluster_role_association" "test" {
+  db_cluster_identifier = aws_rds_cluster.test.id
+  feature_name          = "s3Import"
+  role_arn              = aws_iam_role.test.arn
+}
+
+resource "aws_rds_cluster_role_association" "test2" {
+  db_cluster_identifier = aws_rds_cluster.test.id
+  feature_name          = "s3Export"
+  role_arn              = aws_iam_role.test2.arn
+
+  # Roles cannot be added to a cluster while another association is pending.
+  depends_on = [aws_rds_cluster_role_association.test]
+}
 `, rName))
 }
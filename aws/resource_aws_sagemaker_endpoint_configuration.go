@@ -94,7 +94,7 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 										Required: true,
 										ForceNew: true,
 										ValidateFunc: validation.All(
-											validation.StringMatch(regexp.MustCompile(`^(https|s3)://([^/])/?(.*)$`), ""),
+											validation.StringMatch(regexp.MustCompile(`^(https|s3)://([^/]+)/?(.*)$`), ""),
 											validation.StringLenBetween(1, 512),
 										),
 									},
@@ -595,8 +595,8 @@ func expandSagemakerEndpointConfigClientConfig(configured []interface{}) *sagema
 
 	c := &sagemaker.AsyncInferenceClientConfig{}
 
-	if v, ok := m["max_concurrent_invocations_per_instance"]; ok {
-		c.MaxConcurrentInvocationsPerInstance = aws.Int64(int64(v.(int)))
+	if v, ok := m["max_concurrent_invocations_per_instance"].(int); ok && v != 0 {
+		c.MaxConcurrentInvocationsPerInstance = aws.Int64(int64(v))
 	}
 
 	return c
@@ -613,8 +613,8 @@ func expandSagemakerEndpointConfigOutputConfig(configured []interface{}) *sagema
 		S3OutputPath: aws.String(m["s3_output_path"].(string)),
 	}
 
-	if v, ok := m["kms_key_id"]; ok {
-		c.KmsKeyId = aws.String(v.(string))
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		c.KmsKeyId = aws.String(v)
 	}
 
 	if v, ok := m["notification_config"]; ok && (len(v.([]interface{})) > 0) {
@@ -633,12 +633,12 @@ func expandSagemakerEndpointConfigNotificationConfig(configured []interface{}) *
 
 	c := &sagemaker.AsyncInferenceNotificationConfig{}
 
-	if v, ok := m["error_topic"]; ok {
-		c.ErrorTopic = aws.String(v.(string))
+	if v, ok := m["error_topic"].(string); ok && v != "" {
+		c.ErrorTopic = aws.String(v)
 	}
 
-	if v, ok := m["success_topic"]; ok {
-		c.SuccessTopic = aws.String(v.(string))
+	if v, ok := m["success_topic"].(string); ok && v != "" {
+		c.SuccessTopic = aws.String(v)
 	}
 
 	return c
@@ -331,7 +331,7 @@ func TestAccAWSSagemakerEndpointConfiguration_async_notifConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "async_inference_config.0.output_config.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "async_inference_config.0.output_config.0.s3_output_path"),
 					resource.TestCheckResourceAttr(resourceName, "async_inference_config.0.output_config.0.notification_config.#", "1"),
-					resource.TestCheckResourceAttrPair(resourceName, "async_inference_config.0.output_config.0.notification_config.0.error_topic", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "async_inference_config.0.output_config.0.notification_config.0.error_topic", "aws_sns_topic.error", "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "async_inference_config.0.output_config.0.notification_config.0.success_topic", "aws_sns_topic.test", "arn"),
 				),
 			},
@@ -660,6 +660,10 @@ resource "aws_sns_topic" "test" {
   name = %[1]q
 }
 
+resource "aws_sns_topic" "error" {
+  name = "%[1]s-error"
+}
+
 resource "aws_kms_key" "test" {
   description             = %[1]q
   deletion_window_in_days = 7
@@ -682,7 +686,7 @@ resource "aws_sagemaker_endpoint_configuration" "test" {
       kms_key_id     = aws_kms_key.test.arn
 
       notification_config {
-        error_topic   = aws_sns_topic.test.arn
+        error_topic   = aws_sns_topic.error.arn
         success_topic = aws_sns_topic.test.arn
       }
     }
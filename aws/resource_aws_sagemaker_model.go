@@ -1,26 +1,42 @@
 package aws
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsSagemakerModel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsSagemakerModelCreate,
-		Read:   resourceAwsSagemakerModelRead,
-		Update: resourceAwsSagemakerModelUpdate,
-		Delete: resourceAwsSagemakerModelDelete,
+		CreateContext: resourceAwsSagemakerModelCreate,
+		Read:          resourceAwsSagemakerModelRead,
+		Update:        resourceAwsSagemakerModelUpdate,
+		Delete:        resourceAwsSagemakerModelDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsSagemakerModelImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,6 +80,22 @@ func resourceAwsSagemakerModel() *schema.Resource {
 										ForceNew:     true,
 										ValidateFunc: validation.StringInSlice(sagemaker.RepositoryAccessMode_Values(), false),
 									},
+									"repository_auth_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_credentials_provider_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -80,6 +112,22 @@ func resourceAwsSagemakerModel() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validateSagemakerModelDataUrl,
 						},
+						"multi_model_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"model_cache_setting": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ModelCacheSetting_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -90,9 +138,10 @@ func resourceAwsSagemakerModel() *schema.Resource {
 			},
 			"execution_role_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateSagemakerExecutionRoleArn,
 			},
 			"inference_execution_config": {
 				Type:     schema.TypeList,
@@ -103,13 +152,17 @@ func resourceAwsSagemakerModel() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"mode": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(sagemaker.InferenceExecutionMode_Values(), false),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateSagemakerModelInferenceExecutionMode,
 						},
 					},
 				},
 			},
+			"model_configuration_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,6 +170,10 @@ func resourceAwsSagemakerModel() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateSagemakerName,
 			},
+			"primary_container_image": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"primary_container": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -154,6 +211,22 @@ func resourceAwsSagemakerModel() *schema.Resource {
 										ForceNew:     true,
 										ValidateFunc: validation.StringInSlice(sagemaker.RepositoryAccessMode_Values(), false),
 									},
+									"repository_auth_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_credentials_provider_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -171,9 +244,25 @@ func resourceAwsSagemakerModel() *schema.Resource {
 							ValidateFunc: validateSagemakerModelDataUrl,
 						},
 						"model_package_name": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"multi_model_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"model_cache_setting": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ModelCacheSetting_Values(), false),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -204,11 +293,14 @@ func resourceAwsSagemakerModel() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsSagemakerModelCustomizeDiff,
+			SetTagsDiff,
+		),
 	}
 }
 
-func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsSagemakerModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).sagemakerconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -232,10 +324,14 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 		createOpts.Containers = expandContainers(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("execution_role_arn"); ok {
-		createOpts.ExecutionRoleArn = aws.String(v.(string))
+	executionRoleArn, err := sagemakerModelExecutionRoleArn(d.Get("execution_role_arn").(string), meta.(*AWSClient).SagemakerDefaultExecutionRoleArn)
+
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
+	createOpts.ExecutionRoleArn = aws.String(executionRoleArn)
+
 	if len(tags) > 0 {
 		createOpts.Tags = tags.IgnoreAws().SagemakerTags()
 	}
@@ -253,16 +349,44 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Sagemaker model create config: %#v", *createOpts)
-	_, err := retryOnAwsCode("ValidationException", func() (interface{}, error) {
+	_, err = retrySagemakerModelCreate(iamwaiter.PropagationTimeout, func() (interface{}, error) {
 		return conn.CreateModel(createOpts)
 	})
 
 	if err != nil {
-		return fmt.Errorf("error creating Sagemaker model: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Sagemaker model: %w", err))
 	}
 	d.SetId(name)
 
-	return resourceAwsSagemakerModelRead(d, meta)
+	diags := sagemakerModelNetworkIsolationDiags(d.Get("enable_network_isolation").(bool), d.Get("vpc_config").([]interface{}))
+
+	return append(diags, diag.FromErr(resourceAwsSagemakerModelRead(d, meta))...)
+}
+
+// retrySagemakerModelCreate retries the specified model creation while the execution role
+// has not yet propagated, e.g. "Could not access model data" or "cross-account pass role" errors.
+func retrySagemakerModelCreate(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhen(timeout, f, func(err error) (bool, error) {
+		if err == nil {
+			return false, nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, tfsagemaker.ErrCodeValidationException) {
+			return true, err
+		}
+
+		var awsErr awserr.Error
+
+		if errors.As(err, &awsErr) {
+			message := awsErr.Message()
+
+			if strings.Contains(message, "Could not access model data") || strings.Contains(message, "cross-account pass role") {
+				return true, err
+			}
+		}
+
+		return false, err
+	})
 }
 
 func expandSageMakerVpcConfigRequest(l []interface{}) *sagemaker.VpcConfig {
@@ -278,6 +402,26 @@ func expandSageMakerVpcConfigRequest(l []interface{}) *sagemaker.VpcConfig {
 	}
 }
 
+// resourceAwsSagemakerModelImport verifies that the model exists so that a mistyped name
+// is reported during import rather than on the next plan. Tags are set by the subsequent read.
+func resourceAwsSagemakerModelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	model, err := finder.ModelByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil, fmt.Errorf("error importing Sagemaker model (%s): model not found", d.Id())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error importing Sagemaker model (%s): %w", d.Id(), err)
+	}
+
+	d.SetId(aws.StringValue(model.ModelName))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sagemakerconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -303,10 +447,17 @@ func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("execution_role_arn", model.ExecutionRoleArn)
 	d.Set("enable_network_isolation", model.EnableNetworkIsolation)
 
+	// Container environments are read back so that out-of-band changes force replacement.
 	if err := d.Set("primary_container", flattenContainer(model.PrimaryContainer)); err != nil {
 		return fmt.Errorf("error setting primary_container: %w", err)
 	}
 
+	if model.PrimaryContainer != nil {
+		d.Set("primary_container_image", model.PrimaryContainer.Image)
+	} else {
+		d.Set("primary_container_image", nil)
+	}
+
 	if err := d.Set("container", flattenContainers(model.Containers)); err != nil {
 		return fmt.Errorf("error setting container: %w", err)
 	}
@@ -319,6 +470,12 @@ func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting inference_execution_config: %w", err)
 	}
 
+	hash, err := sagemakerModelConfigurationHash(model)
+	if err != nil {
+		return fmt.Errorf("error computing Sagemaker model (%s) configuration hash: %w", d.Id(), err)
+	}
+	d.Set("model_configuration_hash", hash)
+
 	tags, err := keyvaluetags.SagemakerListTags(conn, arn)
 	if err != nil {
 		return fmt.Errorf("error listing tags for Sagemaker Model (%s): %w", d.Id(), err)
@@ -338,6 +495,40 @@ func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// sagemakerModelConfigurationHash returns a stable hash of the model's creation-time configuration.
+// Only the arguments managed by this resource are hashed, in their flattened form, so that the
+// model name, ARN, tags and any fields added to the API in future SDK versions do not alter the hash.
+func sagemakerModelConfigurationHash(model *sagemaker.DescribeModelOutput) (string, error) {
+	input := map[string]interface{}{
+		"container":                  flattenContainers(model.Containers),
+		"enable_network_isolation":   aws.BoolValue(model.EnableNetworkIsolation),
+		"execution_role_arn":         aws.StringValue(model.ExecutionRoleArn),
+		"inference_execution_config": flattenSagemakerModelInferenceExecutionConfig(model.InferenceExecutionConfig),
+		"primary_container":          flattenContainer(model.PrimaryContainer),
+	}
+
+	// Subnets and security groups are unordered.
+	if vpcConfig := model.VpcConfig; vpcConfig != nil {
+		securityGroupIDs := aws.StringValueSlice(vpcConfig.SecurityGroupIds)
+		sort.Strings(securityGroupIDs)
+		subnets := aws.StringValueSlice(vpcConfig.Subnets)
+		sort.Strings(subnets)
+
+		input["vpc_config"] = map[string]interface{}{
+			"security_group_ids": securityGroupIDs,
+			"subnets":            subnets,
+		}
+	}
+
+	// Map keys are marshalled in sorted order.
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
 func flattenSageMakerVpcConfigResponse(vpcConfig *sagemaker.VpcConfig) []map[string]interface{} {
 	if vpcConfig == nil {
 		return []map[string]interface{}{}
@@ -373,26 +564,219 @@ func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) e
 	}
 	log.Printf("[INFO] Deleting Sagemaker model: %s", d.Id())
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := retrySagemakerModelDelete(5*time.Minute, func() error {
 		_, err := conn.DeleteModel(deleteOpts)
-		if err == nil {
-			return nil
-		}
 
-		if isAWSErr(err, "ResourceNotFound", "") {
-			return resource.RetryableError(err)
-		}
-		return resource.NonRetryableError(err)
+		return err
 	})
-	if isResourceTimeoutError(err) {
-		_, err = conn.DeleteModel(deleteOpts)
+
+	if isSagemakerModelInUseError(err) {
+		if names, findErr := finder.EndpointConfigNamesByModelName(conn, d.Id()); findErr == nil && len(names) > 0 {
+			return fmt.Errorf("Error deleting sagemaker model: in use by endpoint configuration(s) %s: %w", strings.Join(names, ", "), err)
+		}
 	}
+
 	if err != nil {
 		return fmt.Errorf("Error deleting sagemaker model: %w", err)
 	}
 	return nil
 }
 
+// retrySagemakerModelDelete retries the specified model deletion while the model is still in use,
+// e.g. by an endpoint configuration that is being deleted.
+func retrySagemakerModelDelete(timeout time.Duration, f func() error) error {
+	_, err := tfresource.RetryWhen(timeout, func() (interface{}, error) {
+		return nil, f()
+	}, func(err error) (bool, error) {
+		if err == nil {
+			return false, nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, "ResourceNotFound") || isSagemakerModelInUseError(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	return err
+}
+
+// isSagemakerModelInUseError returns whether the error indicates that the model is still referenced.
+func isSagemakerModelInUseError(err error) bool {
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) || awsErr.Code() != tfsagemaker.ErrCodeValidationException {
+		return false
+	}
+
+	message := strings.ToLower(awsErr.Message())
+
+	return strings.Contains(message, "in use") || strings.Contains(message, "is being used")
+}
+
+// resourceAwsSagemakerModelCustomizeDiff reports all invalid argument combinations together
+// so that a single plan shows every configuration problem.
+func resourceAwsSagemakerModelCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs *multierror.Error
+
+	// A model is either a single container model or an inference pipeline, never both.
+	if diff.NewValueKnown("primary_container") && diff.NewValueKnown("container") {
+		primaryContainers := len(diff.Get("primary_container").([]interface{}))
+		containers := len(diff.Get("container").([]interface{}))
+
+		if primaryContainers > 0 && containers > 0 {
+			errs = multierror.Append(errs, fmt.Errorf("only one of primary_container or container can be set"))
+		}
+
+		if primaryContainers == 0 && containers == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("one of primary_container or container must be set"))
+		}
+	}
+
+	// Both Serial and Direct inference execution modes describe how requests flow
+	// between the containers of a multi-container model.
+	if v, ok := diff.GetOk("inference_execution_config.0.mode"); ok && diff.NewValueKnown("container") {
+		if n := len(diff.Get("container").([]interface{})); n < 2 {
+			errs = multierror.Append(errs, fmt.Errorf("inference_execution_config mode %s requires at least two container blocks, got: %d", v.(string), n))
+		}
+	}
+
+	if v, ok := diff.GetOk("primary_container"); ok {
+		if err := validateSagemakerModelContainers("primary_container", v.([]interface{})); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if v, ok := diff.GetOk("container"); ok {
+		if err := validateSagemakerModelContainers("container", v.([]interface{})); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	// Images in a private registry are pulled through the model's VPC.
+	if diff.NewValueKnown("vpc_config") && len(diff.Get("vpc_config").([]interface{})) == 0 {
+		for _, k := range []string{"primary_container", "container"} {
+			for i, tfMapRaw := range diff.Get(k).([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				if v, ok := tfMap["image_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					if mode := v[0].(map[string]interface{})["repository_access_mode"].(string); mode == sagemaker.RepositoryAccessModeVpc {
+						errs = multierror.Append(errs, fmt.Errorf("%s.%d.image_config.0.repository_access_mode %s requires vpc_config to be set", k, i, mode))
+					}
+				}
+			}
+		}
+	}
+
+	// Plan time diagnostics can only be errors, so this combination is only logged here
+	// and reported as a warning when the model is created.
+	if diff.NewValueKnown("enable_network_isolation") && diff.NewValueKnown("vpc_config") {
+		if sagemakerModelNetworkIsolationDiags(diff.Get("enable_network_isolation").(bool), diff.Get("vpc_config").([]interface{})) != nil {
+			log.Printf("[WARN] Sagemaker model (%s) has enable_network_isolation set without vpc_config", diff.Get("name").(string))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sagemakerModelExecutionRoleArn returns the model's execution role, falling back to the provider default.
+func sagemakerModelExecutionRoleArn(executionRoleArn, defaultExecutionRoleArn string) (string, error) {
+	if executionRoleArn != "" {
+		return executionRoleArn, nil
+	}
+
+	if defaultExecutionRoleArn != "" {
+		return defaultExecutionRoleArn, nil
+	}
+
+	return "", fmt.Errorf("execution_role_arn must be set, either on the resource or with the provider sagemaker_default_execution_role_arn argument")
+}
+
+// sagemakerModelNetworkIsolationDiags warns when network isolation is enabled without a VPC configuration,
+// which almost always indicates a misconfiguration.
+func sagemakerModelNetworkIsolationDiags(enableNetworkIsolation bool, vpcConfig []interface{}) diag.Diagnostics {
+	if !enableNetworkIsolation || len(vpcConfig) > 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "enable_network_isolation is set without vpc_config",
+			Detail:        "Network isolated containers cannot make outbound network calls. Without a vpc_config the model has no VPC endpoints through which it can reach Amazon S3 and other services.",
+			AttributePath: cty.GetAttrPath("enable_network_isolation"),
+		},
+	}
+}
+
+// validateSagemakerModelInferenceExecutionMode validates the inference execution mode and
+// warns that Serial pipelines invoke containers in the order they are defined.
+func validateSagemakerModelInferenceExecutionMode(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type to be string")
+	}
+
+	var diags diag.Diagnostics
+
+	_, errs := validation.StringInSlice(sagemaker.InferenceExecutionMode_Values(), false)(v, "mode")
+
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	if v == sagemaker.InferenceExecutionModeSerial {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Serial inference executes containers in the order they are defined",
+			Detail:        "The order of the container blocks defines the order in which containers are invoked. Reordering container blocks changes the inference pipeline.",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// validateSagemakerModelContainers validates combinations of container arguments that the API rejects.
+func validateSagemakerModelContainers(k string, l []interface{}) error {
+	var errs *multierror.Error
+
+	for i, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["image_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			// Image configuration has no effect on containers that only reference a model package.
+			if v, ok := tfMap["image"].(string); !ok || v == "" {
+				errs = multierror.Append(errs, fmt.Errorf("%s.%d.image_config can only be set when %s.%d.image is set", k, i, k, i))
+			}
+
+			imageConfig := v[0].(map[string]interface{})
+
+			// Registry credentials are only used to access private Docker registries in the VPC.
+			if v, ok := imageConfig["repository_auth_config"].([]interface{}); ok && len(v) > 0 {
+				if mode := imageConfig["repository_access_mode"].(string); mode != sagemaker.RepositoryAccessModeVpc {
+					errs = multierror.Append(errs, fmt.Errorf("%s.%d.image_config.0.repository_auth_config can only be set when repository_access_mode is %s, got: %s", k, i, sagemaker.RepositoryAccessModeVpc, mode))
+				}
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func expandContainer(m map[string]interface{}) *sagemaker.ContainerDefinition {
 	container := sagemaker.ContainerDefinition{}
 
@@ -424,6 +808,10 @@ func expandContainer(m map[string]interface{}) *sagemaker.ContainerDefinition {
 		container.ImageConfig = expandSagemakerModelImageConfig(v.([]interface{}))
 	}
 
+	if v, ok := m["multi_model_config"].([]interface{}); ok && len(v) > 0 {
+		container.MultiModelConfig = expandSagemakerModelMultiModelConfig(v)
+	}
+
 	return &container
 }
 
@@ -438,9 +826,43 @@ func expandSagemakerModelImageConfig(l []interface{}) *sagemaker.ImageConfig {
 		RepositoryAccessMode: aws.String(m["repository_access_mode"].(string)),
 	}
 
+	if v, ok := m["repository_auth_config"].([]interface{}); ok && len(v) > 0 {
+		imageConfig.RepositoryAuthConfig = expandSagemakerModelRepositoryAuthConfig(v)
+	}
+
 	return imageConfig
 }
 
+func expandSagemakerModelRepositoryAuthConfig(l []interface{}) *sagemaker.RepositoryAuthConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	repositoryAuthConfig := &sagemaker.RepositoryAuthConfig{
+		RepositoryCredentialsProviderArn: aws.String(m["repository_credentials_provider_arn"].(string)),
+	}
+
+	return repositoryAuthConfig
+}
+
+func expandSagemakerModelMultiModelConfig(l []interface{}) *sagemaker.MultiModelConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	multiModelConfig := &sagemaker.MultiModelConfig{}
+
+	if v, ok := m["model_cache_setting"].(string); ok && v != "" {
+		multiModelConfig.ModelCacheSetting = aws.String(v)
+	}
+
+	return multiModelConfig
+}
+
 func expandContainers(a []interface{}) []*sagemaker.ContainerDefinition {
 	containers := make([]*sagemaker.ContainerDefinition, 0, len(a))
 
@@ -478,14 +900,17 @@ func flattenContainer(container *sagemaker.ContainerDefinition) []interface{} {
 	if container.ModelDataUrl != nil {
 		cfg["model_data_url"] = aws.StringValue(container.ModelDataUrl)
 	}
-	if container.Environment != nil {
-		cfg["environment"] = aws.StringValueMap(container.Environment)
-	}
+	// A nil environment flattens to an empty map, matching an explicitly empty configured map.
+	cfg["environment"] = aws.StringValueMap(container.Environment)
 
 	if container.ImageConfig != nil {
 		cfg["image_config"] = flattenSagemakerImageConfig(container.ImageConfig)
 	}
 
+	if container.MultiModelConfig != nil {
+		cfg["multi_model_config"] = flattenSagemakerMultiModelConfig(container.MultiModelConfig)
+	}
+
 	return []interface{}{cfg}
 }
 
@@ -498,6 +923,34 @@ func flattenSagemakerImageConfig(imageConfig *sagemaker.ImageConfig) []interface
 
 	cfg["repository_access_mode"] = aws.StringValue(imageConfig.RepositoryAccessMode)
 
+	if imageConfig.RepositoryAuthConfig != nil {
+		cfg["repository_auth_config"] = flattenSagemakerRepositoryAuthConfig(imageConfig.RepositoryAuthConfig)
+	}
+
+	return []interface{}{cfg}
+}
+
+func flattenSagemakerRepositoryAuthConfig(repositoryAuthConfig *sagemaker.RepositoryAuthConfig) []interface{} {
+	if repositoryAuthConfig == nil {
+		return []interface{}{}
+	}
+
+	cfg := make(map[string]interface{})
+
+	cfg["repository_credentials_provider_arn"] = aws.StringValue(repositoryAuthConfig.RepositoryCredentialsProviderArn)
+
+	return []interface{}{cfg}
+}
+
+func flattenSagemakerMultiModelConfig(multiModelConfig *sagemaker.MultiModelConfig) []interface{} {
+	if multiModelConfig == nil {
+		return []interface{}{}
+	}
+
+	cfg := make(map[string]interface{})
+
+	cfg["model_cache_setting"] = aws.StringValue(multiModelConfig.ModelCacheSetting)
+
 	return []interface{}{cfg}
 }
 
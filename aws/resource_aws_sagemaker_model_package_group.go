@@ -93,8 +93,8 @@ func resourceAwsSagemakerModelPackageGroupRead(d *schema.ResourceData, meta inte
 	mpg, err := finder.ModelPackageGroupByName(conn, d.Id())
 	if err != nil {
 		if isAWSErr(err, "ValidationException", "does not exist") {
-			d.SetId("")
 			log.Printf("[WARN] Unable to find Sagemaker Model Package Group (%s); removing from state", d.Id())
+			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("error reading SageMaker Model Package Group (%s): %w", d.Id(), err)
@@ -133,7 +133,7 @@ func resourceAwsSagemakerModelPackageGroupUpdate(d *schema.ResourceData, meta in
 		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating SageMaker Model Package Group (%s) tags: %s", d.Id(), err)
+			return fmt.Errorf("error updating SageMaker Model Package Group (%s) tags: %w", d.Id(), err)
 		}
 	}
 
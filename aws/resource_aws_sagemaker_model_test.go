@@ -3,12 +3,17 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/go-cty/cty"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -57,6 +62,404 @@ func testSweepSagemakerModels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestFlattenSagemakerModelContainerEnvironment(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Environment map[string]*string
+		Expected    map[string]string
+	}{
+		{
+			Name:     "nil",
+			Expected: map[string]string{},
+		},
+		{
+			Name:        "values",
+			Environment: map[string]*string{"key1": aws.String("value1"), "key2": aws.String("value2")},
+			Expected:    map[string]string{"key1": "value1", "key2": "value2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			l := flattenContainer(&sagemaker.ContainerDefinition{Environment: testCase.Environment})
+
+			got, ok := l[0].(map[string]interface{})["environment"].(map[string]string)
+
+			if !ok {
+				t.Fatalf("expected environment to be set, got %#v", l[0])
+			}
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d environment variables, got %d", len(testCase.Expected), len(got))
+			}
+
+			for k, v := range testCase.Expected {
+				if got[k] != v {
+					t.Errorf("expected environment variable %q to be %q, got %q", k, v, got[k])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSagemakerModelInferenceExecutionMode(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Value            interface{}
+		ExpectedError    bool
+		ExpectedWarnings int
+	}{
+		{
+			Name:             "Serial",
+			Value:            sagemaker.InferenceExecutionModeSerial,
+			ExpectedWarnings: 1,
+		},
+		{
+			Name:  "Direct",
+			Value: sagemaker.InferenceExecutionModeDirect,
+		},
+		{
+			Name:          "invalid",
+			Value:         "Parallel",
+			ExpectedError: true,
+		},
+		{
+			Name:          "wrong type",
+			Value:         1,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := validateSagemakerModelInferenceExecutionMode(testCase.Value, cty.GetAttrPath("inference_execution_config").IndexInt(0).GetAttr("mode"))
+
+			if got := diags.HasError(); got != testCase.ExpectedError {
+				t.Errorf("expected error %t, got: %v", testCase.ExpectedError, diags)
+			}
+
+			warnings := 0
+			for _, d := range diags {
+				if d.Severity == diag.Warning {
+					warnings++
+				}
+			}
+
+			if warnings != testCase.ExpectedWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", testCase.ExpectedWarnings, warnings, diags)
+			}
+		})
+	}
+}
+
+func TestSagemakerModelNetworkIsolationDiags(t *testing.T) {
+	vpcConfig := []interface{}{
+		map[string]interface{}{
+			"security_group_ids": []interface{}{"sg-12345678"},
+			"subnets":            []interface{}{"subnet-12345678"},
+		},
+	}
+
+	testCases := []struct {
+		Name                   string
+		EnableNetworkIsolation bool
+		VpcConfig              []interface{}
+		ExpectedWarnings       int
+	}{
+		{
+			Name:                   "network isolation without vpc_config",
+			EnableNetworkIsolation: true,
+			ExpectedWarnings:       1,
+		},
+		{
+			Name:                   "network isolation with vpc_config",
+			EnableNetworkIsolation: true,
+			VpcConfig:              vpcConfig,
+		},
+		{
+			Name: "no network isolation",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := sagemakerModelNetworkIsolationDiags(testCase.EnableNetworkIsolation, testCase.VpcConfig)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := len(diags); got != testCase.ExpectedWarnings {
+				t.Fatalf("expected %d warnings, got %d: %v", testCase.ExpectedWarnings, got, diags)
+			}
+
+			for _, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("expected warning, got: %v", d)
+				}
+			}
+		})
+	}
+}
+
+func TestSagemakerModelConfigurationHash(t *testing.T) {
+	newModel := func(name, image string, subnets ...string) *sagemaker.DescribeModelOutput {
+		return &sagemaker.DescribeModelOutput{
+			ExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/test"),
+			ModelArn:         aws.String("arn:aws:sagemaker:us-west-2:123456789012:model/" + name),
+			ModelName:        aws.String(name),
+			PrimaryContainer: &sagemaker.ContainerDefinition{
+				Image: aws.String(image),
+			},
+			VpcConfig: &sagemaker.VpcConfig{
+				SecurityGroupIds: aws.StringSlice([]string{"sg-12345678"}),
+				Subnets:          aws.StringSlice(subnets),
+			},
+		}
+	}
+
+	hash := func(model *sagemaker.DescribeModelOutput) string {
+		v, err := sagemakerModelConfigurationHash(model)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return v
+	}
+
+	base := hash(newModel("test1", "image:1", "subnet-1", "subnet-2"))
+
+	if v := hash(newModel("test2", "image:1", "subnet-2", "subnet-1")); v != base {
+		t.Errorf("expected hash to ignore name and subnet order, got %s, want %s", v, base)
+	}
+
+	withCreationTime := newModel("test1", "image:1", "subnet-1", "subnet-2")
+	withCreationTime.CreationTime = aws.Time(time.Now())
+
+	if v := hash(withCreationTime); v != base {
+		t.Errorf("expected hash to ignore fields not managed by the resource, got %s, want %s", v, base)
+	}
+
+	if v := hash(newModel("test1", "image:2", "subnet-1", "subnet-2")); v == base {
+		t.Errorf("expected hash to change when the container image changes, got %s", v)
+	}
+}
+
+func TestRetrySagemakerModelCreate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Errors        []error
+		ExpectError   bool
+		ExpectedCalls int
+	}{
+		{
+			Name:          "success",
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "role not propagated then success",
+			Errors:        []error{awserr.New("AccessDeniedException", "Could not access model data at s3://bucket/model.tar.gz", nil)},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "cross-account pass role then success",
+			Errors:        []error{awserr.New("AccessDeniedException", "cross-account pass role is not allowed", nil)},
+			ExpectedCalls: 2,
+		},
+		{
+			Name: "multiple transient errors then success",
+			Errors: []error{
+				awserr.New("AccessDeniedException", "Could not access model data at s3://bucket/model.tar.gz", nil),
+				awserr.New("AccessDeniedException", "cross-account pass role is not allowed", nil),
+			},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "validation exception then success",
+			Errors:        []error{awserr.New("ValidationException", "Could not assume role", nil)},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "non-retryable error",
+			Errors:        []error{awserr.New("ResourceLimitExceeded", "limit exceeded", nil)},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			calls := 0
+
+			_, err := retrySagemakerModelCreate(10*time.Second, func() (interface{}, error) {
+				calls++
+
+				if calls <= len(testCase.Errors) {
+					return nil, testCase.Errors[calls-1]
+				}
+
+				return &sagemaker.CreateModelOutput{}, nil
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.ExpectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.ExpectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetrySagemakerModelDelete(t *testing.T) {
+	inUseErr := awserr.New("ValidationException", "Cannot delete model tf-acc-test because it is in use by endpoint configuration tf-acc-test", nil)
+
+	testCases := []struct {
+		Name          string
+		Errors        []error
+		ExpectError   bool
+		ExpectedCalls int
+	}{
+		{
+			Name:          "success",
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "in use then free",
+			Errors:        []error{inUseErr, inUseErr},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "non-retryable validation error",
+			Errors:        []error{awserr.New("ValidationException", "Could not find model", nil)},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			calls := 0
+
+			err := retrySagemakerModelDelete(10*time.Second, func() error {
+				calls++
+
+				if calls <= len(testCase.Errors) {
+					return testCase.Errors[calls-1]
+				}
+
+				return nil
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.ExpectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.ExpectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestIsSagemakerModelInUseError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:     "in use",
+			Err:      awserr.New("ValidationException", "Model is in use by endpoint configuration", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped in use",
+			Err:      fmt.Errorf("error: %w", awserr.New("ValidationException", "Model is being used by an endpoint", nil)),
+			Expected: true,
+		},
+		{
+			Name: "other validation error",
+			Err:  awserr.New("ValidationException", "Could not find model", nil),
+		},
+		{
+			Name: "other error code",
+			Err:  awserr.New("ResourceInUse", "Model is in use", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isSagemakerModelInUseError(testCase.Err); got != testCase.Expected {
+				t.Errorf("expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestSagemakerModelExecutionRoleArn(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		ExecutionRoleArn string
+		DefaultRoleArn   string
+		Expected         string
+		ExpectError      bool
+	}{
+		{
+			Name:             "resource role",
+			ExecutionRoleArn: "arn:aws:iam::123456789012:role/resource",
+			DefaultRoleArn:   "arn:aws:iam::123456789012:role/default",
+			Expected:         "arn:aws:iam::123456789012:role/resource",
+		},
+		{
+			Name:           "provider default role",
+			DefaultRoleArn: "arn:aws:iam::123456789012:role/default",
+			Expected:       "arn:aws:iam::123456789012:role/default",
+		},
+		{
+			Name:        "no role",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sagemakerModelExecutionRoleArn(testCase.ExecutionRoleArn, testCase.DefaultRoleArn)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected %q, got %q", testCase.Expected, got)
+			}
+		})
+	}
+}
+
 func TestAccAWSSagemakerModel_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -74,6 +477,7 @@ func TestAccAWSSagemakerModel_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "primary_container.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "primary_container.0.image", "data.aws_sagemaker_prebuilt_ecr_image.test", "registry_path"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_container_image", "data.aws_sagemaker_prebuilt_ecr_image.test", "registry_path"),
 					resource.TestCheckResourceAttr(resourceName, "primary_container.0.mode", "SingleModel"),
 					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
@@ -119,6 +523,27 @@ func TestAccAWSSagemakerModel_inferenceExecutionConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_inferenceExecutionConfigSingleContainer(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelInferenceExecutionConfigSingleContainer(rName, sagemaker.InferenceExecutionModeSerial),
+				ExpectError: regexp.MustCompile(`inference_execution_config mode Serial requires at least two container blocks`),
+			},
+			{
+				Config:      testAccSagemakerModelInferenceExecutionConfigSingleContainer(rName, sagemaker.InferenceExecutionModeDirect),
+				ExpectError: regexp.MustCompile(`inference_execution_config mode Direct requires at least two container blocks`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_tags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -163,6 +588,85 @@ func TestAccAWSSagemakerModel_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_modelConfigurationHash(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+	var hash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					testAccCheckSagemakerModelConfigurationHash(resourceName, &hash, false),
+				),
+			},
+			{
+				Config: testAccSagemakerModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					testAccCheckSagemakerModelConfigurationHash(resourceName, &hash, false),
+				),
+			},
+			{
+				Config: testAccSagemakerModelConfigImage(rName, "linear-learner"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					testAccCheckSagemakerModelConfigurationHash(resourceName, &hash, true),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSSagemakerModel_tagsLegacyState verifies that state written by a provider
+// version that predates tags_all (3.37.0) refreshes without a resulting diff.
+func TestAccAWSSagemakerModel_tagsLegacyState(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "3.37.0",
+					},
+				},
+				Config: testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.%"),
+				),
+			},
+			{
+				ProviderFactories: testAccProviderFactories,
+				Config:            testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				PlanOnly:          true,
+			},
+			{
+				ProviderFactories: testAccProviderFactories,
+				Config:            testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_primaryContainerModelDataUrl(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -242,6 +746,106 @@ func TestAccAWSSagemakerModel_primaryContainerImageConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_primaryContainerImageConfigWithoutImage(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerPrimaryContainerImageConfigWithoutImageConfig(rName),
+				ExpectError: regexp.MustCompile(`primary_container.0.image_config can only be set when primary_container.0.image is set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_primaryContainerRepositoryAuthConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerPrimaryContainerRepositoryAuthConfigConfig(rName, sagemaker.RepositoryAccessModePlatform),
+				ExpectError: regexp.MustCompile(`primary_container.0.image_config.0.repository_auth_config can only be set when repository_access_mode is Vpc`),
+			},
+			{
+				Config: testAccSagemakerPrimaryContainerRepositoryAuthConfigConfig(rName, sagemaker.RepositoryAccessModeVpc),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.image_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.image_config.0.repository_access_mode", "Vpc"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.image_config.0.repository_auth_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_container.0.image_config.0.repository_auth_config.0.repository_credentials_provider_arn", lambdaFunctionResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_containersRepositoryAuthConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerContainersRepositoryAuthConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image_config.0.repository_access_mode", "Vpc"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image_config.0.repository_auth_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "container.0.image_config.0.repository_auth_config.0.repository_credentials_provider_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.image_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_primaryContainerRepositoryAccessModeVpcWithoutVpcConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerPrimaryContainerRepositoryAccessModeVpcWithoutVpcConfigConfig(rName),
+				ExpectError: regexp.MustCompile(`primary_container.0.image_config.0.repository_access_mode Vpc requires vpc_config to be set`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_primaryContainerEnvironment(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -269,6 +873,36 @@ func TestAccAWSSagemakerModel_primaryContainerEnvironment(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_primaryContainerEnvironmentEmpty(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerPrimaryContainerEnvironmentEmptyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "0"),
+				),
+			},
+			{
+				Config:   testAccSagemakerPrimaryContainerEnvironmentEmptyConfig(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_primaryContainerModeSingle(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -295,6 +929,34 @@ func TestAccAWSSagemakerModel_primaryContainerModeSingle(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_primaryContainerMultiModelConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerPrimaryContainerMultiModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.mode", "MultiModel"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.multi_model_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.multi_model_config.0.model_cache_setting", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_containers(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -323,6 +985,74 @@ func TestAccAWSSagemakerModel_containers(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_primaryContainerAndContainers(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelPrimaryContainerAndContainers(rName),
+				ExpectError: regexp.MustCompile(`only one of primary_container or container can be set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_noContainers(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelNoContainers(rName),
+				ExpectError: regexp.MustCompile(`one of primary_container or container must be set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_multipleConfigErrors(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelConfigMultipleConfigErrors(rName),
+				ExpectError: regexp.MustCompile(`(?s)4 errors occurred.*only one of primary_container or container can be set.*inference_execution_config mode Direct requires at least two container blocks.*primary_container.0.image_config can only be set when primary_container.0.image is set.*primary_container.0.image_config.0.repository_access_mode Vpc requires vpc_config to be set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_nameTooLong(t *testing.T) {
+	rName := strings.Repeat("a", 64)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelConfig(rName),
+				ExpectError: regexp.MustCompile(`"name" cannot be longer than 63 characters`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_vpcConfig(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
@@ -369,9 +1099,72 @@ func TestAccAWSSagemakerModel_networkIsolation(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_providerDefaultExecutionRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        testAccErrorCheck(t, sagemaker.EndpointsID),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The provider configuration can only reference the role once it exists.
+				Config: testAccSagemakerModelConfigBase(rName),
+			},
+			{
+				Config: testAccSagemakerModelConfigProviderDefaultExecutionRole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_noExecutionRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerModelConfigNoExecutionRole(rName),
+				ExpectError: regexp.MustCompile(`execution_role_arn must be set, either on the resource or with the provider sagemaker_default_execution_role_arn argument`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_importNonexistent(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccSagemakerModelConfig(rName),
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s-nonexistent", rName),
+				ExpectError:   regexp.MustCompile(`model not found`),
 			},
 		},
 	})
@@ -454,6 +1247,37 @@ func testAccCheckSagemakerModelExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckSagemakerModelConfigurationHash verifies whether the model_configuration_hash
+// has changed since the previous check and records the current value.
+func testAccCheckSagemakerModelConfigurationHash(n string, hash *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		v := rs.Primary.Attributes["model_configuration_hash"]
+
+		if v == "" {
+			return fmt.Errorf("model_configuration_hash is not set")
+		}
+
+		if *hash != "" {
+			if changed && v == *hash {
+				return fmt.Errorf("expected model_configuration_hash to change, got: %s", v)
+			}
+
+			if !changed && v != *hash {
+				return fmt.Errorf("expected model_configuration_hash %s, got: %s", *hash, v)
+			}
+		}
+
+		*hash = v
+
+		return nil
+	}
+}
+
 func testAccSagemakerModelConfigBase(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -492,6 +1316,55 @@ resource "aws_sagemaker_model" "test" {
 `, rName)
 }
 
+func testAccSagemakerModelConfigProviderDefaultExecutionRole(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+provider "aws" {
+  alias = "default_execution_role"
+
+  sagemaker_default_execution_role_arn = aws_iam_role.test.arn
+}
+
+resource "aws_sagemaker_model" "test" {
+  provider = aws.default_execution_role
+
+  name = %[1]q
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+}
+`, rName)
+}
+
+func testAccSagemakerModelConfigNoExecutionRole(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name = %[1]q
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+}
+`, rName)
+}
+
+func testAccSagemakerModelConfigImage(rName, repositoryName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+data "aws_sagemaker_prebuilt_ecr_image" "image" {
+  repository_name = %[2]q
+}
+
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.image.registry_path
+  }
+}
+`, rName, repositoryName)
+}
+
 func testAccSagemakerModelInferenceExecutionConfig(rName string) string {
 	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_model" "test" {
@@ -513,6 +1386,23 @@ resource "aws_sagemaker_model" "test" {
 `, rName)
 }
 
+func testAccSagemakerModelInferenceExecutionConfigSingleContainer(rName, mode string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  inference_execution_config {
+    mode = %[2]q
+  }
+
+  container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+}
+`, rName, mode)
+}
+
 func testAccSagemakerModelConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_model" "test" {
@@ -556,7 +1446,7 @@ resource "aws_sagemaker_model" "test" {
 
   primary_container {
     image          = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
-    model_data_url = "https://s3.amazonaws.com/${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+    model_data_url = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
   }
 }
 
@@ -650,6 +1540,166 @@ resource "aws_sagemaker_model" "test" {
 `, rName)
 }
 
+func testAccSagemakerPrimaryContainerImageConfigWithoutImageConfig(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    model_package_name = "arn:${data.aws_partition.current.partition}:sagemaker:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:model-package/%[1]s/1"
+
+    image_config {
+      repository_access_mode = "Platform"
+    }
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
+func testAccSagemakerPrimaryContainerRepositoryAccessModeVpcWithoutVpcConfigConfig(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name                     = %[1]q
+  execution_role_arn       = aws_iam_role.test.arn
+  enable_network_isolation = true
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+
+    image_config {
+      repository_access_mode = "Vpc"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSagemakerModelConfigRepositoryAuthConfigBase(rName string) string {
+	return composeConfig(
+		testAccSagemakerModelConfigBase(rName),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Principal": {
+      "Service": "lambda.amazonaws.com"
+    },
+    "Effect": "Allow"
+  }]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block        = "10.1.1.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccSagemakerPrimaryContainerRepositoryAuthConfigConfig(rName, repositoryAccessMode string) string {
+	return composeConfig(
+		testAccSagemakerModelConfigRepositoryAuthConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image = "registry.example.com/test:latest"
+
+    image_config {
+      repository_access_mode = %[2]q
+
+      repository_auth_config {
+        repository_credentials_provider_arn = aws_lambda_function.test.arn
+      }
+    }
+  }
+
+  vpc_config {
+    subnets            = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`, rName, repositoryAccessMode))
+}
+
+func testAccSagemakerContainersRepositoryAuthConfigConfig(rName string) string {
+	return composeConfig(
+		testAccSagemakerModelConfigRepositoryAuthConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  container {
+    image = "registry.example.com/test:latest"
+
+    image_config {
+      repository_access_mode = "Vpc"
+
+      repository_auth_config {
+        repository_credentials_provider_arn = aws_lambda_function.test.arn
+      }
+    }
+  }
+
+  container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+
+  vpc_config {
+    subnets            = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`, rName))
+}
+
 func testAccSagemakerPrimaryContainerEnvironmentConfig(rName string) string {
 	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_model" "test" {
@@ -667,6 +1717,20 @@ resource "aws_sagemaker_model" "test" {
 `, rName)
 }
 
+func testAccSagemakerPrimaryContainerEnvironmentEmptyConfig(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image       = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+    environment = {}
+  }
+}
+`, rName)
+}
+
 func testAccSagemakerPrimaryContainerModeSingle(rName string) string {
 	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_model" "test" {
@@ -681,6 +1745,88 @@ resource "aws_sagemaker_model" "test" {
 `, rName)
 }
 
+func testAccSagemakerPrimaryContainerMultiModelConfig(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image          = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+    mode           = "MultiModel"
+    model_data_url = "s3://${aws_s3_bucket.test.bucket}/models/"
+
+    multi_model_config {
+      model_cache_setting = "Disabled"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccSagemakerModelPrimaryContainerAndContainers(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+
+  container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+}
+`, rName)
+}
+
+func testAccSagemakerModelConfigMultipleConfigErrors(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    model_package_name = "arn:${data.aws_partition.current.partition}:sagemaker:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:model-package/%[1]s/1"
+
+    image_config {
+      repository_access_mode = "Vpc"
+    }
+  }
+
+  container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+
+  inference_execution_config {
+    mode = "Direct"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
+func testAccSagemakerModelNoContainers(rName string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+}
+`, rName)
+}
+
 func testAccSagemakerModelContainers(rName string) string {
 	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_model" "test" {
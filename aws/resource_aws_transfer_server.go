@@ -36,6 +36,7 @@ func resourceAwsTransferServer() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			SetTagsDiff,
+			resourceAwsTransferServerCustomizeDiff,
 			customdiff.ForceNewIfChange("endpoint_details.0.vpc_id", func(_ context.Context, old, new, meta interface{}) bool {
 				// "InvalidRequestException: Changing VpcId is not supported".
 				if old, new := old.(string), new.(string); old != "" && new != old {
@@ -130,6 +131,12 @@ func resourceAwsTransferServer() *schema.Resource {
 				Default:  false,
 			},
 
+			"graceful_shutdown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"host_key": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -162,6 +169,26 @@ func resourceAwsTransferServer() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
+			"protocol_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"passive_ip": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.Any(
+								validation.StringInSlice([]string{tftransfer.PassiveIpAuto}, false),
+								validation.IsIPv4Address,
+							),
+						},
+					},
+				},
+			},
+
 			"protocols": {
 				Type:     schema.TypeSet,
 				MinItems: 1,
@@ -181,6 +208,13 @@ func resourceAwsTransferServer() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(tftransfer.SecurityPolicyName_Values(), false),
 			},
 
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{transfer.StateOffline, transfer.StateOnline}, false),
+			},
+
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
 
@@ -192,6 +226,26 @@ func resourceAwsTransferServer() *schema.Resource {
 	}
 }
 
+func resourceAwsTransferServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// VPC_ENDPOINT is deprecated, existing servers can only be migrated away from it.
+	if diff.Id() != "" && diff.HasChange("endpoint_type") {
+		o, n := diff.GetChange("endpoint_type")
+
+		if o, n := o.(string), n.(string); n == transfer.EndpointTypeVpcEndpoint {
+			return fmt.Errorf("endpoint_type cannot be changed from %s to %s, use %s instead", o, n, transfer.EndpointTypeVpc)
+		}
+	}
+
+	// Endpoint details only apply to VPC and VPC_ENDPOINT endpoint types.
+	if v, ok := diff.GetOk("endpoint_details"); ok && len(v.([]interface{})) > 0 {
+		if endpointType := diff.Get("endpoint_type").(string); endpointType == transfer.EndpointTypePublic {
+			return fmt.Errorf("endpoint_details cannot be set when endpoint_type is %s", endpointType)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).transferconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -250,6 +304,10 @@ func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) e
 		input.LoggingRole = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("protocol_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ProtocolDetails = expandTransferProtocolDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("protocols"); ok && v.(*schema.Set).Len() > 0 {
 		input.Protocols = expandStringSet(v.(*schema.Set))
 	}
@@ -302,7 +360,13 @@ func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) e
 			return err
 		}
 
-		if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if d.Get("state").(string) != transfer.StateOffline {
+			if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	} else if d.Get("state").(string) == transfer.StateOffline {
+		if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return err
 		}
 	}
@@ -360,6 +424,14 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("endpoint_details", nil)
 	}
 	d.Set("endpoint_type", output.EndpointType)
+	// A configured host key that no longer matches the server's fingerprint was replaced outside of Terraform.
+	// Clear it so that the next apply imports the configured key again.
+	if v, ok := d.GetOk("host_key"); ok && v.(string) != "" {
+		if old := d.Get("host_key_fingerprint").(string); old != "" && old != aws.StringValue(output.HostKeyFingerprint) {
+			log.Printf("[WARN] Transfer Server (%s) host key fingerprint changed from %s to %s outside of Terraform", d.Id(), old, aws.StringValue(output.HostKeyFingerprint))
+			d.Set("host_key", "")
+		}
+	}
 	d.Set("host_key_fingerprint", output.HostKeyFingerprint)
 	d.Set("identity_provider_type", output.IdentityProviderType)
 	if output.IdentityProviderDetails != nil {
@@ -368,14 +440,23 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("invocation_role", "")
 	}
 	d.Set("logging_role", output.LoggingRole)
+	if output.ProtocolDetails != nil {
+		if err := d.Set("protocol_details", []interface{}{flattenTransferProtocolDetails(output.ProtocolDetails)}); err != nil {
+			return fmt.Errorf("error setting protocol_details: %w", err)
+		}
+	} else {
+		d.Set("protocol_details", nil)
+	}
 	d.Set("protocols", aws.StringValueSlice(output.Protocols))
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("state", output.State)
 	if output.IdentityProviderDetails != nil {
 		d.Set("url", output.IdentityProviderDetails.Url)
 	} else {
 		d.Set("url", "")
 	}
 
+	// Tags are returned by DescribeServer, so no separate tag listing permission is required.
 	tags := keyvaluetags.TransferKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -393,7 +474,15 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).transferconn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	// The server's state before this update, used to skip redundant stop and start calls.
+	oldState := d.Get("state").(string)
+	if d.HasChange("state") {
+		o, _ := d.GetChange("state")
+		oldState = o.(string)
+	}
+	newState := d.Get("state").(string)
+
+	if d.HasChangesExcept("state", "tags", "tags_all") {
 		var newEndpointTypeVpc bool
 		var oldEndpointTypeVpc bool
 
@@ -531,6 +620,12 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 			input.LoggingRole = aws.String(d.Get("logging_role").(string))
 		}
 
+		if d.HasChange("protocol_details") {
+			if v, ok := d.GetOk("protocol_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ProtocolDetails = expandTransferProtocolDetails(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("protocols") {
 			input.Protocols = expandStringSet(d.Get("protocols").(*schema.Set))
 		}
@@ -539,10 +634,12 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 			input.SecurityPolicyName = aws.String(d.Get("security_policy_name").(string))
 		}
 
-		if offlineUpdate {
+		if offlineUpdate && oldState != transfer.StateOffline {
 			if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
 			}
+
+			oldState = transfer.StateOffline
 		}
 
 		if removeAddressAllocationIDs {
@@ -564,6 +661,17 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 			return err
 		}
 
+		if input.HostKey != nil {
+			if oldState != transfer.StateOffline {
+				if _, err := waiter.ServerStarted(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Transfer Server (%s) host key update: %w", d.Id(), err)
+				}
+			}
+
+			// The previous fingerprint no longer applies and must not be mistaken for out-of-band drift.
+			d.Set("host_key_fingerprint", "")
+		}
+
 		if len(addressAllocationIDs) > 0 {
 			input := &transfer.UpdateServerInput{
 				ServerId: aws.String(d.Id()),
@@ -578,7 +686,30 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 			}
 		}
 
-		if offlineUpdate {
+		if offlineUpdate && newState != transfer.StateOffline {
+			if d.HasChange("endpoint_type") {
+				if _, err := conn.StartServer(&transfer.StartServerInput{ServerId: aws.String(d.Id())}); err != nil {
+					return fmt.Errorf("error starting Transfer Server (%s): %w", d.Id(), err)
+				}
+
+				if _, err := waiter.ServerEndpointUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Transfer Server (%s) endpoint type update: %w", d.Id(), err)
+				}
+			} else if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+
+			oldState = transfer.StateOnline
+		}
+	}
+
+	if newState != oldState {
+		switch newState {
+		case transfer.StateOffline:
+			if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		case transfer.StateOnline:
 			if err := startTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
 			}
@@ -634,6 +765,20 @@ func resourceAwsTransferServerDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// Stop the server first so that active connections are drained before deletion.
+	if d.Get("graceful_shutdown").(bool) && d.Get("state").(string) != transfer.StateOffline {
+		log.Printf("[DEBUG] Stopping Transfer Server before deletion: (%s)", d.Id())
+		err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+		if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Transfer Server: (%s)", d.Id())
 	_, err := conn.DeleteServer(&transfer.DeleteServerInput{
 		ServerId: aws.String(d.Id()),
@@ -718,6 +863,34 @@ func flattenTransferEndpointDetails(apiObject *transfer.EndpointDetails, securit
 	return tfMap
 }
 
+func expandTransferProtocolDetails(tfMap map[string]interface{}) *transfer.ProtocolDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.ProtocolDetails{}
+
+	if v, ok := tfMap["passive_ip"].(string); ok && v != "" {
+		apiObject.PassiveIp = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTransferProtocolDetails(apiObject *transfer.ProtocolDetails) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PassiveIp; v != nil {
+		tfMap["passive_ip"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func stopTransferServer(conn *transfer.Transfer, serverID string, timeout time.Duration) error {
 	input := &transfer.StopServerInput{
 		ServerId: aws.String(serverID),
@@ -3,14 +3,22 @@ package aws
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/transfer/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
@@ -70,6 +78,95 @@ func testSweepTransferServers(region string) error {
 	return nil
 }
 
+func TestResourceAwsTransferServerDelete_gracefulShutdown(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		GracefulShutdown   bool
+		ExpectedOperations []string
+	}{
+		{
+			Name:               "disabled",
+			GracefulShutdown:   false,
+			ExpectedOperations: []string{"DeleteServer", "DescribeServer"},
+		},
+		{
+			Name:               "enabled",
+			GracefulShutdown:   true,
+			ExpectedOperations: []string{"StopServer", "DescribeServer", "DeleteServer", "DescribeServer"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var mu sync.Mutex
+			var operations []string
+			state := transfer.StateOnline
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				operation := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "TransferService.")
+				operations = append(operations, operation)
+
+				w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+				switch operation {
+				case "StopServer":
+					state = transfer.StateOffline
+					fmt.Fprint(w, `{}`)
+				case "DeleteServer":
+					state = ""
+					fmt.Fprint(w, `{}`)
+				case "DescribeServer":
+					if state == "" {
+						w.WriteHeader(http.StatusBadRequest)
+						fmt.Fprint(w, `{"__type": "ResourceNotFoundException", "Message": "Unknown server"}`)
+						return
+					}
+
+					fmt.Fprintf(w, `{"Server": {"Arn": "arn:aws:transfer:us-west-2:123456789012:server/s-12345678901234567", "ServerId": "s-12345678901234567", "State": %q}}`, state) // lintignore:AWSAT003,AWSAT005
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprintf(w, `{"__type": "InvalidRequestException", "Message": "Unexpected operation %s"}`, operation)
+				}
+			}))
+			defer server.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("test", "test", ""),
+				Endpoint:    aws.String(server.URL),
+				MaxRetries:  aws.Int(0),
+				Region:      aws.String("us-west-2"), // lintignore:AWSAT003
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			meta := &AWSClient{
+				transferconn: transfer.New(sess),
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceAwsTransferServer().Schema, map[string]interface{}{
+				"graceful_shutdown": testCase.GracefulShutdown,
+			})
+			d.SetId("s-12345678901234567")
+
+			if err := resourceAwsTransferServerDelete(d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !reflect.DeepEqual(operations, testCase.ExpectedOperations) {
+				t.Errorf("expected operations %v, got %v", testCase.ExpectedOperations, operations)
+			}
+		})
+	}
+}
+
 func testAccErrorCheckSkipTransfer(t *testing.T) resource.ErrorCheckFunc {
 	return testAccErrorCheckSkipMessagesContaining(t,
 		"Invalid server type: PUBLIC",
@@ -107,6 +204,7 @@ func testAccAWSTransferServer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "SFTP"),
 					resource.TestCheckResourceAttr(resourceName, "security_policy_name", "TransferSecurityPolicy-2018-11"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ONLINE"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "url", ""),
 				),
@@ -115,7 +213,7 @@ func testAccAWSTransferServer_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerUpdatedConfig(rName),
@@ -136,6 +234,7 @@ func testAccAWSTransferServer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "SFTP"),
 					resource.TestCheckResourceAttr(resourceName, "security_policy_name", "TransferSecurityPolicy-2018-11"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ONLINE"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "url", ""),
 				),
@@ -165,7 +264,7 @@ func testAccAWSTransferServer_domain(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -214,7 +313,7 @@ func testAccAWSTransferServer_securityPolicy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerSecurityPolicyConfig("TransferSecurityPolicy-2018-11"),
@@ -227,6 +326,87 @@ func testAccAWSTransferServer_securityPolicy(t *testing.T) {
 	})
 }
 
+func testAccAWSTransferServer_protocolDetails(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSTransferServerProtocolDetailsConfig("not-an-ip"),
+				ExpectError: regexp.MustCompile(`expected protocol_details.0.passive_ip to`),
+			},
+			{
+				Config: testAccAWSTransferServerProtocolDetailsConfig("AUTO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.passive_ip", "AUTO"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
+			},
+			{
+				Config: testAccAWSTransferServerProtocolDetailsConfig("8.8.8.8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.passive_ip", "8.8.8.8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSTransferServer_state(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerStateConfig(transfer.StateOffline),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "state", transfer.StateOffline),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
+			},
+			{
+				Config: testAccAWSTransferServerStateConfig(transfer.StateOnline),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "state", transfer.StateOnline),
+				),
+			},
+			{
+				Config: testAccAWSTransferServerStateConfig(transfer.StateOffline),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "state", transfer.StateOffline),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSTransferServer_vpc(t *testing.T) {
 	var conf transfer.DescribedServer
 	resourceName := "aws_transfer_server.test"
@@ -259,7 +439,7 @@ func testAccAWSTransferServer_vpc(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerVpcUpdateConfig(rName),
@@ -330,7 +510,7 @@ func testAccAWSTransferServer_vpcAddressAllocationIds(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerVpcAddressAllocationIdsUpdateConfig(rName),
@@ -398,7 +578,7 @@ func testAccAWSTransferServer_vpcSecurityGroupIds(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerVpcSecurityGroupIdsUpdateConfig(rName),
@@ -455,7 +635,7 @@ func testAccAWSTransferServer_vpcAddressAllocationIds_securityGroupIds(t *testin
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			{
 				Config: testAccAWSTransferServerVpcAddressAllocationIdsSecurityGroupIdsUpdateConfig(rName),
@@ -508,7 +688,33 @@ func testAccAWSTransferServer_updateEndpointType_publicToVpc(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
+			},
+		},
+	})
+}
+
+func testAccAWSTransferServer_updateEndpointType_vpcToVpcEndpoint(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerVpcConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "VPC"),
+				),
+			},
+			{
+				Config:      testAccAWSTransferServerVpcEndpointConfig(rName),
+				ExpectError: regexp.MustCompile(`endpoint_type cannot be changed from VPC to VPC_ENDPOINT`),
 			},
 		},
 	})
@@ -545,7 +751,7 @@ func testAccAWSTransferServer_updateEndpointType_publicToVpc_addressAllocationId
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -582,7 +788,7 @@ func testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -619,7 +825,7 @@ func testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc_addressAllocat
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -656,7 +862,7 @@ func testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc_securityGroupI
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -693,7 +899,7 @@ func testAccAWSTransferServer_updateEndpointType_vpcToPublic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -728,7 +934,7 @@ func testAccAWSTransferServer_protocols(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 			// We need to create and activate the CA before issuing a certificate.
 			{
@@ -762,6 +968,23 @@ func testAccAWSTransferServer_protocols(t *testing.T) {
 	})
 }
 
+func testAccAWSTransferServer_endpointDetailsPublic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSTransferServerEndpointDetailsPublicConfig(rName),
+				ExpectError: regexp.MustCompile(`endpoint_details cannot be set when endpoint_type is PUBLIC`),
+			},
+		},
+	})
+}
+
 func testAccAWSTransferServer_apiGateway(t *testing.T) {
 	var conf transfer.DescribedServer
 	resourceName := "aws_transfer_server.test"
@@ -785,7 +1008,7 @@ func testAccAWSTransferServer_apiGateway(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -814,7 +1037,7 @@ func testAccAWSTransferServer_apiGateway_forceDestroy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -847,7 +1070,7 @@ func testAccAWSTransferServer_directoryService(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown"},
 			},
 		},
 	})
@@ -886,7 +1109,7 @@ func testAccAWSTransferServer_forceDestroy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "host_key"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown", "host_key"},
 			},
 		},
 	})
@@ -914,7 +1137,7 @@ func testAccAWSTransferServer_hostKey(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "host_key"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown", "host_key"},
 			},
 		},
 	})
@@ -953,12 +1176,63 @@ func testAccAWSTransferServer_vpcEndpointId(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "host_key"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "graceful_shutdown", "host_key"},
+			},
+		},
+	})
+}
+
+func testAccAWSTransferServer_hostKeyRotatedOutOfBand(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	hostKey := "test-fixtures/transfer-ssh-rsa-key"
+	hostKeyFingerprint := "SHA256:Z2pW9sPKDD/T34tVfCoolsRcECNTlekgaKvDn9t+9sg="
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTransferServerHostKeyConfig(hostKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "host_key_fingerprint", hostKeyFingerprint),
+					testAccCheckAWSTransferServerRotateHostKey(&conf),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSTransferServerHostKeyConfig(hostKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTransferServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "host_key_fingerprint", hostKeyFingerprint),
+				),
 			},
 		},
 	})
 }
 
+func testAccCheckAWSTransferServerRotateHostKey(v *transfer.DescribedServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).transferconn
+
+		_, privateKey, err := acctest.RandSSHKeyPair(testAccDefaultEmailAddress)
+
+		if err != nil {
+			return fmt.Errorf("error generating SSH key pair: %w", err)
+		}
+
+		_, err = conn.UpdateServer(&transfer.UpdateServerInput{
+			HostKey:  aws.String(privateKey),
+			ServerId: v.ServerId,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckAWSTransferServerExists(n string, v *transfer.DescribedServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1228,6 +1502,24 @@ resource "aws_transfer_server" "test" {
 `, policy)
 }
 
+func testAccAWSTransferServerProtocolDetailsConfig(passiveIP string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  protocol_details {
+    passive_ip = %[1]q
+  }
+}
+`, passiveIP)
+}
+
+func testAccAWSTransferServerStateConfig(state string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  state = %[1]q
+}
+`, state)
+}
+
 func testAccAWSTransferServerUpdatedConfig(rName string) string {
 	return composeConfig(
 		testAccAWSTransferServerConfigBaseLoggingRole(rName),
@@ -1549,6 +1841,21 @@ resource "aws_transfer_server" "test" {
 `)
 }
 
+func testAccAWSTransferServerEndpointDetailsPublicConfig(rName string) string {
+	return composeConfig(
+		testAccAWSTransferServerConfigBaseVpc(rName),
+		`
+resource "aws_transfer_server" "test" {
+  endpoint_type = "PUBLIC"
+
+  endpoint_details {
+    subnet_ids = [aws_subnet.test.id]
+    vpc_id     = aws_vpc.test.id
+  }
+}
+`)
+}
+
 func testAccAWSTransferServerConfigRootCA(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "test" {
@@ -19,16 +19,21 @@ func TestAccAWSTransfer_serial(t *testing.T) {
 			"APIGatewayForceDestroy":        testAccAWSTransferServer_apiGateway_forceDestroy,
 			"DirectoryService":              testAccAWSTransferServer_directoryService,
 			"Domain":                        testAccAWSTransferServer_domain,
+			"EndpointDetailsPublic":         testAccAWSTransferServer_endpointDetailsPublic,
 			"ForceDestroy":                  testAccAWSTransferServer_forceDestroy,
 			"HostKey":                       testAccAWSTransferServer_hostKey,
+			"HostKeyRotatedOutOfBand":       testAccAWSTransferServer_hostKeyRotatedOutOfBand,
+			"ProtocolDetails":               testAccAWSTransferServer_protocolDetails,
 			"Protocols":                     testAccAWSTransferServer_protocols,
 			"SecurityPolicy":                testAccAWSTransferServer_securityPolicy,
+			"State":                         testAccAWSTransferServer_state,
 			"UpdateEndpointTypePublicToVPC": testAccAWSTransferServer_updateEndpointType_publicToVpc,
 			"UpdateEndpointTypePublicToVPCAddressAllocationIDs":      testAccAWSTransferServer_updateEndpointType_publicToVpc_addressAllocationIds,
 			"UpdateEndpointTypeVPCEndpointToVPC":                     testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc,
 			"UpdateEndpointTypeVPCEndpointToVPCAddressAllocationIDs": testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc_addressAllocationIds,
 			"UpdateEndpointTypeVPCEndpointToVPCSecurityGroupIDs":     testAccAWSTransferServer_updateEndpointType_vpcEndpointToVpc_securityGroupIds,
 			"UpdateEndpointTypeVPCToPublic":                          testAccAWSTransferServer_updateEndpointType_vpcToPublic,
+			"UpdateEndpointTypeVPCToVPCEndpoint":                     testAccAWSTransferServer_updateEndpointType_vpcToVpcEndpoint,
 			"VPC":                                                    testAccAWSTransferServer_vpc,
 			"VPCAddressAllocationIDs":                                testAccAWSTransferServer_vpcAddressAllocationIds,
 			"VPCAddressAllocationIDsSecurityGroupIDs":                testAccAWSTransferServer_vpcAddressAllocationIds_securityGroupIds,
@@ -39,12 +44,14 @@ func TestAccAWSTransfer_serial(t *testing.T) {
 			"basic": testAccAWSTransferSshKey_basic,
 		},
 		"User": {
-			"basic":                 testAccAWSTransferUser_basic,
-			"disappears":            testAccAWSTransferUser_disappears,
-			"HomeDirectoryMappings": testAccAWSTransferUser_homeDirectoryMappings,
-			"ModifyWithOptions":     testAccAWSTransferUser_modifyWithOptions,
-			"Posix":                 testAccAWSTransferUser_posix,
-			"UserNameValidation":    testAccAWSTransferUser_UserName_Validation,
+			"basic":                             testAccAWSTransferUser_basic,
+			"disappears":                        testAccAWSTransferUser_disappears,
+			"HomeDirectoryMappings":             testAccAWSTransferUser_homeDirectoryMappings,
+			"HomeDirectoryMappingsLogicalEmpty": testAccAWSTransferUser_homeDirectoryMappingsLogicalEmpty,
+			"ModifyWithOptions":                 testAccAWSTransferUser_modifyWithOptions,
+			"Posix":                             testAccAWSTransferUser_posix,
+			"PosixNonEFSServer":                 testAccAWSTransferUser_posixNonEFSServer,
+			"UserNameValidation":                testAccAWSTransferUser_UserName_Validation,
 		},
 	}
 
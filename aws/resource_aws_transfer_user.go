@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -120,10 +122,44 @@ func resourceAwsTransferUser() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsTransferUserCustomizeDiff,
+		),
 	}
 }
 
+func resourceAwsTransferUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A LOGICAL home directory only exposes the configured mappings.
+	if homeDirectoryType := diff.Get("home_directory_type").(string); homeDirectoryType == transfer.HomeDirectoryTypeLogical && diff.NewValueKnown("home_directory_mappings") {
+		if len(diff.Get("home_directory_mappings").([]interface{})) == 0 {
+			return fmt.Errorf("home_directory_type %s requires at least one home_directory_mappings block", homeDirectoryType)
+		}
+	}
+
+	return nil
+}
+
+// transferUserValidatePosixProfileServer returns an error if the specified server does not use EFS storage.
+// A server that cannot be found is not considered an error.
+func transferUserValidatePosixProfileServer(conn *transfer.Transfer, serverID string) error {
+	server, err := finder.ServerByID(conn, serverID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transfer Server (%s): %w", serverID, err)
+	}
+
+	if domain := aws.StringValue(server.Domain); domain != transfer.DomainEfs {
+		return fmt.Errorf("posix_profile requires a Transfer Server with domain %s, server %s has domain %s", transfer.DomainEfs, serverID, domain)
+	}
+
+	return nil
+}
+
 func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).transferconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -155,6 +191,10 @@ func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if v, ok := d.GetOk("posix_profile"); ok {
+		if err := transferUserValidatePosixProfileServer(conn, serverID); err != nil {
+			return err
+		}
+
 		input.PosixProfile = expandTransferUserPosixUser(v.([]interface{}))
 	}
 
@@ -256,6 +296,12 @@ func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if d.HasChange("posix_profile") {
+			if v, ok := d.GetOk("posix_profile"); ok && len(v.([]interface{})) > 0 {
+				if err := transferUserValidatePosixProfileServer(conn, serverID); err != nil {
+					return err
+				}
+			}
+
 			input.PosixProfile = expandTransferUserPosixUser(d.Get("posix_profile").([]interface{}))
 		}
 
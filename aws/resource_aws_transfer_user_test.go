@@ -82,6 +82,23 @@ func testAccAWSTransferUser_posix(t *testing.T) {
 	})
 }
 
+func testAccAWSTransferUser_posixNonEFSServer(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSTransferUserConfigPosixNonEFSServer(rName),
+				ExpectError: regexp.MustCompile(`posix_profile requires a Transfer Server with domain EFS`),
+			},
+		},
+	})
+}
+
 func testAccAWSTransferUser_modifyWithOptions(t *testing.T) {
 	var conf transfer.DescribedUser
 	resourceName := "aws_transfer_user.test"
@@ -225,6 +242,23 @@ func testAccAWSTransferUser_homeDirectoryMappings(t *testing.T) {
 	})
 }
 
+func testAccAWSTransferUser_homeDirectoryMappingsLogicalEmpty(t *testing.T) {
+	rName := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSTransfer(t) },
+		ErrorCheck:   testAccErrorCheck(t, transfer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTransferUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSTransferUserConfig_homeDirectoryMappingsLogicalEmpty(rName),
+				ExpectError: regexp.MustCompile(`home_directory_type LOGICAL requires at least one home_directory_mappings block`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSTransferUserExists(n string, res *transfer.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -726,6 +760,38 @@ resource "aws_transfer_user" "test" {
 `, rName))
 }
 
+func testAccAWSTransferUserConfig_homeDirectoryMappingsLogicalEmpty(rName string) string {
+	return composeConfig(
+		testAccAWSTransferUserConfig_base,
+		fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-test-transfer-user-iam-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_transfer_user" "test" {
+  home_directory_type = "LOGICAL"
+  role                = aws_iam_role.test.arn
+  server_id           = aws_transfer_server.test.id
+  user_name           = "tftestuser"
+}
+`, rName))
+}
+
 func testAccAWSTransferUserConfig_homeDirectoryMappingsUpdate(rName string) string {
 	return composeConfig(
 		testAccAWSTransferUserConfig_base,
@@ -850,6 +916,40 @@ resource "aws_transfer_user" "test" {
 `, rName)
 }
 
+func testAccAWSTransferUserConfigPosixNonEFSServer(rName string) string {
+	return composeConfig(testAccAWSTransferUserConfig_base, fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-test-transfer-user-iam-role-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_transfer_user" "test" {
+  server_id = aws_transfer_server.test.id
+  user_name = "tftestuser"
+  role      = aws_iam_role.test.arn
+
+  posix_profile {
+    gid = 1000
+    uid = 1000
+  }
+}
+`, rName))
+}
+
 func testAccAWSTransferUserConfigPosixUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_transfer_server" "test" {
@@ -3921,19 +3921,21 @@ func expandAppmeshVirtualServiceSpec(vSpec []interface{}) *appmesh.VirtualServic
 	return spec
 }
 
-func flattenAppmeshVirtualServiceSpec(spec *appmesh.VirtualServiceSpec) []interface{} {
+func flattenAppmeshVirtualServiceSpec(spec *appmesh.VirtualServiceSpec, meshARN string) []interface{} {
 	if spec == nil {
 		return []interface{}{}
 	}
 
 	mSpec := map[string]interface{}{}
 
-	if spec.Provider != nil {
+	// An empty provider is returned after the provider has been cleared.
+	if spec.Provider != nil && (spec.Provider.VirtualNode != nil || spec.Provider.VirtualRouter != nil) {
 		mProvider := map[string]interface{}{}
 
 		if spec.Provider.VirtualNode != nil {
 			mProvider["virtual_node"] = []interface{}{
 				map[string]interface{}{
+					"virtual_node_arn":  fmt.Sprintf("%s/virtualNode/%s", meshARN, aws.StringValue(spec.Provider.VirtualNode.VirtualNodeName)),
 					"virtual_node_name": aws.StringValue(spec.Provider.VirtualNode.VirtualNodeName),
 				},
 			}
@@ -3942,6 +3944,7 @@ func flattenAppmeshVirtualServiceSpec(spec *appmesh.VirtualServiceSpec) []interf
 		if spec.Provider.VirtualRouter != nil {
 			mProvider["virtual_router"] = []interface{}{
 				map[string]interface{}{
+					"virtual_router_arn":  fmt.Sprintf("%s/virtualRouter/%s", meshARN, aws.StringValue(spec.Provider.VirtualRouter.VirtualRouterName)),
 					"virtual_router_name": aws.StringValue(spec.Provider.VirtualRouter.VirtualRouterName),
 				},
 			}
@@ -4099,7 +4102,7 @@ func expandAppmeshGrpcRoute(vGrpcRoute []interface{}) *appmesh.GrpcRoute {
 
 		mGrpcRetryPolicy := vGrpcRetryPolicy[0].(map[string]interface{})
 
-		if vMaxRetries, ok := mGrpcRetryPolicy["max_retries"].(int); ok && vMaxRetries > 0 {
+		if vMaxRetries, ok := mGrpcRetryPolicy["max_retries"].(int); ok && vMaxRetries >= 0 {
 			grpcRetryPolicy.MaxRetries = aws.Int64(int64(vMaxRetries))
 		}
 
@@ -4261,7 +4264,7 @@ func expandAppmeshHttpRoute(vHttpRoute []interface{}) *appmesh.HttpRoute {
 
 		mHttpRetryPolicy := vHttpRetryPolicy[0].(map[string]interface{})
 
-		if vMaxRetries, ok := mHttpRetryPolicy["max_retries"].(int); ok && vMaxRetries > 0 {
+		if vMaxRetries, ok := mHttpRetryPolicy["max_retries"].(int); ok && vMaxRetries >= 0 {
 			httpRetryPolicy.MaxRetries = aws.Int64(int64(vMaxRetries))
 		}
 
@@ -12,8 +12,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -518,9 +520,59 @@ func validateSagemakerModelDataUrl(v interface{}, k string) (ws []string, errors
 		errors = append(errors, fmt.Errorf(
 			"%q must be a path that starts with either s3 or https: %q", k, value))
 	}
+	if regexp.MustCompile(`^https://([^/]+\.)?s3([.-][^/]+)?\.amazonaws\.com(\.cn)?(/|$)`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must use the s3://bucket/key form for S3 locations, not an HTTPS or presigned URL: %q", k, value))
+	}
 	return
 }
 
+func validateSagemakerExecutionRoleArn(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validateArn(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	value := v.(string)
+
+	if value == "" {
+		return ws, errors
+	}
+
+	parsedARN, _ := arn.Parse(value)
+
+	if strings.HasPrefix(parsedARN.Resource, "instance-profile/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is an IAM instance profile ARN, use the ARN of the IAM role instead", k, value))
+	}
+
+	return ws, errors
+}
+
+func validateAppmeshResourceName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "arn:") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a name, not an ARN", k, value))
+	}
+
+	return ws, errors
+}
+
+func validateEksIdentityProviderConfigIssuerUrl(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = validation.IsURLWithHTTPS(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	if value := v.(string); len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters, got: %d", k, len(value)))
+	}
+
+	return ws, errors
+}
+
 func validateCloudWatchDashboardName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 255 {
@@ -1011,6 +1063,20 @@ func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+// validateDbEventSubscriptionSourceType validates the RDS event subscription source type.
+// db-proxy is listed explicitly as it is not yet part of rds.SourceType_Values().
+func validateDbEventSubscriptionSourceType(v interface{}, k string) (ws []string, errors []error) {
+	return validation.StringInSlice([]string{
+		rds.SourceTypeDbCluster,
+		rds.SourceTypeDbClusterSnapshot,
+		rds.SourceTypeDbInstance,
+		rds.SourceTypeDbParameterGroup,
+		"db-proxy",
+		rds.SourceTypeDbSecurityGroup,
+		rds.SourceTypeDbSnapshot,
+	}, false)(v, k)
+}
+
 func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error) {
 	// IAM Policy documents need to be valid JSON, and pass legacy parsing
 	value := v.(string)
@@ -2379,6 +2445,41 @@ func validateEKSClusterName(v interface{}, k string) (ws []string, errors []erro
 	return
 }
 
+// validateEKSFargateProfileSelectorLabels validates selector labels against the Kubernetes label syntax rules.
+// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
+func validateEKSFargateProfileSelectorLabels(v interface{}, k string) (ws []string, errors []error) {
+	namePattern := regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixPattern := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+	for key, raw := range v.(map[string]interface{}) {
+		name := key
+
+		if i := strings.LastIndex(key, "/"); i != -1 {
+			prefix := key[:i]
+			name = key[i+1:]
+
+			if len(prefix) > 253 || !prefixPattern.MatchString(prefix) {
+				errors = append(errors, fmt.Errorf(
+					"%q: label key prefix must be a DNS subdomain of at most 253 characters: %q", k, key))
+			}
+		}
+
+		if len(name) > 63 || !namePattern.MatchString(name) {
+			errors = append(errors, fmt.Errorf(
+				"%q: label key name must be at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character: %q", k, key))
+		}
+
+		value, _ := raw.(string)
+
+		if len(value) > 63 || (value != "" && !namePattern.MatchString(value)) {
+			errors = append(errors, fmt.Errorf(
+				"%q: label value must be empty or at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character: %q", k, value))
+		}
+	}
+
+	return
+}
+
 var validateCloudWatchEventCustomEventBusName = validation.All(
 	validation.StringLenBetween(1, 256),
 	validation.StringMatch(regexp.MustCompile(`^[/\.\-_A-Za-z0-9]+$`), ""),
@@ -2498,3 +2599,8 @@ func validateVersionString(v interface{}, k string) (ws []string, errors []error
 
 	return
 }
+
+// validateAppmeshMeshEgressFilterType validates that a mesh egress filter type is one the App Mesh API accepts.
+func validateAppmeshMeshEgressFilterType(v interface{}, k string) (ws []string, errors []error) {
+	return validation.StringInSlice(appmesh.EgressFilterType_Values(), false)(v, k)
+}
@@ -840,6 +840,147 @@ func TestValidateSagemakerName(t *testing.T) {
 	}
 }
 
+func TestValidateSagemakerModelDataUrl(t *testing.T) {
+	validUrls := []string{
+		"s3://bucket/model.tar.gz",
+		"s3://bucket/path/to/model.tar.gz",
+		"s3://bucket/models/",
+		"https://example.com/model.tar.gz",
+	}
+	for _, v := range validUrls {
+		_, errors := validateSagemakerModelDataUrl(v, "model_data_url")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker model data URL: %q", v, errors)
+		}
+	}
+
+	invalidUrls := []string{
+		"bucket/model.tar.gz",
+		"ftp://bucket/model.tar.gz",
+		"https://s3.amazonaws.com/bucket/model.tar.gz",
+		"https://bucket.s3.amazonaws.com/model.tar.gz",
+		"https://bucket.s3.us-west-2.amazonaws.com/model.tar.gz?X-Amz-Signature=abc123", // lintignore:AWSAT003
+		"https://s3-us-west-2.amazonaws.com/bucket/model.tar.gz",                        // lintignore:AWSAT003
+		"https://bucket.s3.cn-north-1.amazonaws.com.cn/model.tar.gz",                    // lintignore:AWSAT003
+	}
+	for _, v := range invalidUrls {
+		_, errors := validateSagemakerModelDataUrl(v, "model_data_url")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker model data URL", v)
+		}
+	}
+}
+
+func TestValidateSagemakerExecutionRoleArn(t *testing.T) {
+	validArns := []string{
+		"arn:aws:iam::123456789012:role/SageMakerRole",              // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/SageMakerRole", // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/SageMakerRole",       // lintignore:AWSAT005
+	}
+	for _, v := range validArns {
+		_, errors := validateSagemakerExecutionRoleArn(v, "execution_role_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker execution role ARN: %q", v, errors)
+		}
+	}
+
+	invalidArns := []string{
+		"SageMakerRole",
+		"arn:aws:iam::123456789012:instance-profile/SageMakerProfile",      // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:instance-profile/path/SageMakerProfile", // lintignore:AWSAT005
+	}
+	for _, v := range invalidArns {
+		_, errors := validateSagemakerExecutionRoleArn(v, "execution_role_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker execution role ARN", v)
+		}
+	}
+}
+
+func TestValidateAppmeshResourceName(t *testing.T) {
+	validNames := []string{
+		"serviceBv1",
+		"service-b-v1",
+		"servicea.simpleapp.local",
+	}
+	for _, v := range validNames {
+		_, errors := validateAppmeshResourceName(v, "virtual_node_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid App Mesh resource name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn:aws:appmesh:us-west-2:123456789012:mesh/simpleapp/virtualNode/serviceBv1",     // lintignore:AWSAT003,AWSAT005
+		"arn:aws:appmesh:us-west-2:123456789012:mesh/simpleapp/virtualRouter/serviceB",     // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:appmesh:us-gov-west-1:123456789012:mesh/simpleapp/virtualNode/foo", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAppmeshResourceName(v, "virtual_node_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid App Mesh resource name", v)
+		}
+	}
+}
+
+func TestValidateEksIdentityProviderConfigIssuerUrl(t *testing.T) {
+	validUrls := []string{
+		"https://example.com",
+		"https://oidc.example.com/path",
+		"https://" + strings.Repeat("a", 243) + ".com",
+	}
+	for _, v := range validUrls {
+		_, errors := validateEksIdentityProviderConfigIssuerUrl(v, "issuer_url")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid EKS identity provider issuer URL: %q", v, errors)
+		}
+	}
+
+	invalidUrls := []string{
+		"http://example.com",
+		"example.com",
+		"https://" + strings.Repeat("a", 244) + ".com",
+	}
+	for _, v := range invalidUrls {
+		_, errors := validateEksIdentityProviderConfigIssuerUrl(v, "issuer_url")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid EKS identity provider issuer URL", v)
+		}
+	}
+}
+
+func TestValidateDbEventSubscriptionSourceType(t *testing.T) {
+	validTypes := []string{
+		"db-instance",
+		"db-cluster",
+		"db-parameter-group",
+		"db-security-group",
+		"db-snapshot",
+		"db-cluster-snapshot",
+		"db-proxy",
+	}
+	for _, v := range validTypes {
+		_, errors := validateDbEventSubscriptionSourceType(v, "source_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RDS Event Subscription source type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{
+		"db-instances",
+		"DB-INSTANCE",
+		"db_instance",
+		"cluster",
+		"",
+	}
+	for _, v := range invalidTypes {
+		_, errors := validateDbEventSubscriptionSourceType(v, "source_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RDS Event Subscription source type", v)
+		}
+	}
+}
+
 func TestValidateDbEventSubscriptionName(t *testing.T) {
 	validNames := []string{
 		"valid-name",
@@ -3345,3 +3486,69 @@ func TestResourceAWSEKSClusterNameValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateEKSFargateProfileSelectorLabels(t *testing.T) {
+	validLabels := []map[string]interface{}{
+		{"app": "web"},
+		{"app.kubernetes.io/name": "web"},
+		{"example.com/tier": ""},
+		{"a": strings.Repeat("b", 63)},
+		{strings.Repeat("a", 63): "value_1.2-3"},
+		{strings.Repeat("a", 253) + "/name": "value"},
+	}
+	for _, v := range validLabels {
+		_, errors := validateEKSFargateProfileSelectorLabels(v, "labels")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid EKS Fargate Profile selector labels: %q", v, errors)
+		}
+	}
+
+	invalidLabels := []map[string]interface{}{
+		{"": "web"},
+		{"-app": "web"},
+		{"app-": "web"},
+		{"app name": "web"},
+		{"Example.com/app": "web"},
+		{"example..com/app": "web"},
+		{"/app": "web"},
+		{"example.com/": "web"},
+		{strings.Repeat("a", 64): "web"},
+		{strings.Repeat("a", 254) + "/name": "web"},
+		{"app": "-web"},
+		{"app": "web app"},
+		{"app": strings.Repeat("b", 64)},
+	}
+	for _, v := range invalidLabels {
+		_, errors := validateEKSFargateProfileSelectorLabels(v, "labels")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid EKS Fargate Profile selector labels", v)
+		}
+	}
+}
+
+func TestValidateAppmeshMeshEgressFilterType(t *testing.T) {
+	validTypes := []string{
+		"ALLOW_ALL",
+		"DROP_ALL",
+	}
+	for _, v := range validTypes {
+		_, errors := validateAppmeshMeshEgressFilterType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid App Mesh egress filter type: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{
+		"",
+		"allow_all",
+		"Drop_All",
+		"EGRESS_WITH_INTERNET",
+		"DENY_ALL",
+	}
+	for _, v := range invalidTypes {
+		_, errors := validateAppmeshMeshEgressFilterType(v, "type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid App Mesh egress filter type", v)
+		}
+	}
+}